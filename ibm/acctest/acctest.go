@@ -5,10 +5,15 @@ package acctest
 
 import (
 	"fmt"
+	"math/rand"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/replay"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -1108,3 +1113,74 @@ func TestAccPreCheckEncryptedImage(t *testing.T) {
 		t.Fatal("IS_IMAGE_ENCRYPTION_KEY must be set for acceptance tests")
 	}
 }
+
+// Replay records or replays the HTTP interactions of an acceptance test, depending
+// on IBM_ACCTEST_REPLAY. It must be called before the test draws any random names,
+// and the returned function must be deferred:
+//
+//	defer acc.Replay(t)()
+//
+// When IBM_ACCTEST_REPLAY is set to "record" the interactions are recorded, with
+// credentials scrubbed, in testdata/cassettes/<test name>.json of the test package.
+// When it is set to "replay" every service endpoint is pointed at a local server
+// that answers from that cassette, so the test runs without credentials or network
+// access. Tests that have no cassette are skipped in replay mode. When it is not
+// set the test runs live.
+func Replay(t *testing.T) func() {
+	mode := replay.Mode(os.Getenv("IBM_ACCTEST_REPLAY"))
+	if mode == "" {
+		return func() {}
+	}
+
+	path := filepath.Join("testdata", "cassettes", strings.Replace(t.Name(), "/", "_", -1)+".json")
+	if mode == replay.ModeReplay {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			t.Skipf("no cassette recorded for %s", t.Name())
+		}
+	}
+	server, err := replay.NewServer(mode, path, conns.EnvFallBack([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "us-south"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	env := server.Endpoints()
+	if mode == replay.ModeReplay {
+		env["IC_REGION"] = server.Region()
+		env["IC_API_KEY"] = replay.Redacted
+		env["IAAS_CLASSIC_API_KEY"] = replay.Redacted
+		env["IAAS_CLASSIC_USERNAME"] = replay.Redacted
+	}
+	restore := setenv(env)
+
+	// Random resource names must match the ones in the cassette
+	rand.Seed(server.Seed())
+
+	return func() {
+		restore()
+		if err := server.Close(); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// setenv sets the environment variables and returns a function that restores them
+func setenv(env map[string]string) func() {
+	old := make(map[string]*string, len(env))
+	for k, v := range env {
+		if prev, ok := os.LookupEnv(k); ok {
+			old[k] = &prev
+		} else {
+			old[k] = nil
+		}
+		os.Setenv(k, v)
+	}
+	return func() {
+		for k, v := range old {
+			if v == nil {
+				os.Unsetenv(k)
+			} else {
+				os.Setenv(k, *v)
+			}
+		}
+	}
+}
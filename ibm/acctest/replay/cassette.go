@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package replay
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Cassette holds the HTTP interactions of one acceptance test run
+type Cassette struct {
+	// Seed is used to seed math/rand so that the random resource names of
+	// the test are the same when the cassette is replayed
+	Seed         int64          `json:"seed"`
+	Region       string         `json:"region"`
+	Interactions []*Interaction `json:"interactions"`
+
+	mu sync.Mutex
+}
+
+// Interaction is a request made by the provider and the response returned by the API
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+
+	replayed bool
+}
+
+// Request is a recorded API request. Endpoint is the environment variable that
+// names the service endpoint, for example IBMCLOUD_IS_NG_API_ENDPOINT, and Path
+// is relative to it.
+type Request struct {
+	Method   string      `json:"method"`
+	Endpoint string      `json:"endpoint"`
+	Path     string      `json:"path"`
+	Query    string      `json:"query,omitempty"`
+	Headers  http.Header `json:"headers,omitempty"`
+	Body     string      `json:"body,omitempty"`
+}
+
+// Response is a recorded API response
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette written by Save
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &Cassette{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Save writes the cassette to path, creating the parent directory if needed
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+func (c *Cassette) add(i *Interaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, i)
+}
+
+// next returns the first interaction matching the request that has not been
+// replayed yet. Request bodies are not compared as they may contain timestamps.
+// Once every matching interaction has been replayed the last one is returned
+// again, so that polling for a status can take more attempts than it did while
+// recording.
+func (c *Cassette) next(method, endpoint, path, query string) *Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	query = canonicalQuery(query)
+	var last *Interaction
+	for _, i := range c.Interactions {
+		r := i.Request
+		if r.Method != method || r.Endpoint != endpoint || r.Path != path || canonicalQuery(r.Query) != query {
+			continue
+		}
+		if !i.replayed {
+			i.replayed = true
+			return i
+		}
+		last = i
+	}
+	return last
+}
+
+func canonicalQuery(query string) string {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return query
+	}
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := append([]string(nil), values[k]...)
+		sort.Strings(v)
+		parts = append(parts, k+"="+strings.Join(v, ","))
+	}
+	return strings.Join(parts, "&")
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package replay
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// endpoints maps the environment variables that override the service endpoints
+// used by conns.ClientSession, the Bluemix endpoint locator and the COS and
+// SoftLayer clients to their default public endpoint. %[1]s is replaced by the
+// region. The default is the upstream of the endpoint while recording, unless
+// the variable is already set.
+var endpoints = map[string]string{
+	"IAAS_CLASSIC_ENDPOINT_URL":                      "https://api.softlayer.com/rest/v3",
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT":       "https://accounts.cloud.ibm.com",
+	"IBMCLOUD_API_GATEWAY_ENDPOINT":                  "https://api.%[1]s.apigw.cloud.ibm.com/controller",
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT":         "https://%[1]s.appid.cloud.ibm.com",
+	"IBMCLOUD_ATRACKER_API_ENDPOINT":                 "https://%[1]s.atracker.cloud.ibm.com",
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT":       "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta",
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT":      "https://%[1]s.certificate-manager.cloud.ibm.com",
+	"IBMCLOUD_CIS_API_ENDPOINT":                      "https://api.cis.cloud.ibm.com",
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT":              "https://api.shell.cloud.ibm.com",
+	"IBMCLOUD_COMPLIANCE_API_ENDPOINT":               "https://us.compliance.cloud.ibm.com",
+	"IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT": "https://us.compliance.cloud.ibm.com",
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT":   "https://cbr.cloud.ibm.com",
+	"IBMCLOUD_COS_CONFIG_ENDPOINT":                   "https://config.cloud-object-storage.cloud.ibm.com/v1",
+	"IBMCLOUD_COS_ENDPOINT":                          "https://s3.%[1]s.cloud-object-storage.appdomain.cloud",
+	"IBMCLOUD_CR_API_ENDPOINT":                       "https://us.icr.io",
+	"IBMCLOUD_CS_API_ENDPOINT":                       "https://containers.cloud.ibm.com/global",
+	"IBMCLOUD_CSE_ENDPOINT":                          "https://api.serviceendpoint.cloud.ibm.com",
+	"IBMCLOUD_DATABASES_API_ENDPOINT":                "https://api.%[1]s.databases.cloud.ibm.com/v5/ibm",
+	"IBMCLOUD_DL_API_ENDPOINT":                       "https://directlink.cloud.ibm.com/v1",
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT":              "https://directlink.cloud.ibm.com/provider/v2",
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT":               "https://enterprise.cloud.ibm.com/v1",
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT":      "https://%[1]s.event-notifications.cloud.ibm.com/event-notifications",
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT":                "https://%[1]s.functions.cloud.ibm.com",
+	"IBMCLOUD_GS_API_ENDPOINT":                       "https://api.global-search-tagging.cloud.ibm.com",
+	"IBMCLOUD_GT_API_ENDPOINT":                       "https://tags.global-search-tagging.cloud.ibm.com",
+	"IBMCLOUD_HPCS_API_ENDPOINT":                     "https://%[1]s.broker.hs-crypto.cloud.ibm.com/crypto_v2/",
+	"IBMCLOUD_IAM_API_ENDPOINT":                      "https://iam.cloud.ibm.com",
+	"IBMCLOUD_IAMPAP_API_ENDPOINT":                   "https://iam.cloud.ibm.com",
+	"IBMCLOUD_ICD_API_ENDPOINT":                      "https://api.%[1]s.databases.cloud.ibm.com",
+	"IBMCLOUD_IS_NG_API_ENDPOINT":                    "https://%[1]s.iaas.cloud.ibm.com/v1",
+	"IBMCLOUD_KP_API_ENDPOINT":                       "https://%[1]s.kms.cloud.ibm.com",
+	"IBMCLOUD_MCCP_API_ENDPOINT":                     "https://mccp.%[1]s.cf.cloud.ibm.com",
+	"IBMCLOUD_PI_API_ENDPOINT":                       "https://%[1]s.power-iaas.cloud.ibm.com",
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT":              "https://api.dns-svcs.cloud.ibm.com/v1",
+	"IBMCLOUD_PUSH_API_ENDPOINT":                     "https://%[1]s.imfpush.cloud.ibm.com/imfpush/v1",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT":         "https://globalcatalog.cloud.ibm.com",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT":      "https://resource-controller.cloud.ibm.com",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT":      "https://resource-controller.cloud.ibm.com",
+	"IBMCLOUD_SAT_API_ENDPOINT":                      "https://containers.cloud.ibm.com",
+	"IBMCLOUD_SATELLITE_API_ENDPOINT":                "https://containers.cloud.ibm.com/global",
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT":           "https://api.link.satellite.cloud.ibm.com",
+	"IBMCLOUD_SCC_ADMIN_API_ENDPOINT":                "https://us.compliance.cloud.ibm.com",
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT":               "https://schematics.cloud.ibm.com",
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT":              "https://api.%[1]s.devops.cloud.ibm.com/v2",
+	"IBMCLOUD_TG_API_ENDPOINT":                       "https://transit.cloud.ibm.com/v1",
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT":                    "https://api.%[1]s.devops.cloud.ibm.com",
+	"IBMCLOUD_UAA_ENDPOINT":                          "https://iam.cloud.ibm.com/cloudfoundry/login/%[1]s",
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT":              "https://user-management.cloud.ibm.com",
+}
+
+// upstreams returns the endpoints that the recording proxy forwards to
+func upstreams(region string) map[string]string {
+	u := make(map[string]string, len(endpoints))
+	for env, url := range endpoints {
+		if v := os.Getenv(env); v != "" {
+			u[env] = v
+			continue
+		}
+		if strings.Contains(url, "%") {
+			url = fmt.Sprintf(url, region)
+		}
+		u[env] = url
+	}
+	return u
+}
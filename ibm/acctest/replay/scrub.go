@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package replay
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// Redacted replaces secrets in recorded interactions
+	Redacted = "REDACTED"
+
+	// AccountID and IAMID replace the account and identity of the user who
+	// recorded a cassette
+	AccountID = "00000000000000000000000000000000"
+	IAMID     = "IBMid-0000000000"
+)
+
+// AccessToken is the unsigned token that replaces IAM access tokens in cassettes.
+// It expires in 2100 so that the SDK authenticators never try to refresh it.
+var AccessToken = fakeJWT(map[string]interface{}{
+	"iam_id":  IAMID,
+	"id":      IAMID,
+	"sub":     "replay@ibm.com",
+	"email":   "replay@ibm.com",
+	"account": map[string]interface{}{"bss": AccountID},
+	"iat":     1640995200,
+	"exp":     4102444800,
+})
+
+// Headers that carry credentials
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Refresh-Token",
+	"X-Auth-Refresh-Token",
+	"X-Auth-Uaa-Token",
+	"X-Auth-Token",
+	"X-Api-Key",
+	"Apikey",
+	"Set-Cookie",
+	"Cookie",
+}
+
+// Headers that are different on every request and only add noise to a cassette
+var volatileHeaders = []string{
+	"Content-Length",
+	"Content-Encoding",
+	"Date",
+	"Transaction-Id",
+	"X-Correlation-Id",
+	"X-Request-Id",
+	"X-Global-Transaction-Id",
+}
+
+var (
+	jwtPattern       = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	jsonFieldPattern = regexp.MustCompile(`"(apikey|api_key|password|refresh_token|uaa_refresh_token|delegated_refresh_token|ims_token|access_token|uaa_token)"(\s*):(\s*)"[^"]*"`)
+	formFieldPattern = regexp.MustCompile(`(^|&)(apikey|password|refresh_token|passcode)=[^&]*`)
+)
+
+// scrubber removes credentials and the identity of the recording user from
+// recorded interactions
+type scrubber struct {
+	mu           sync.Mutex
+	replacements map[string]string
+}
+
+// newScrubber returns a scrubber that replaces every occurrence of the given
+// secrets, such as the API keys the test run was started with
+func newScrubber(secrets ...string) *scrubber {
+	s := &scrubber{replacements: map[string]string{}}
+	for _, secret := range secrets {
+		s.replace(secret, Redacted)
+	}
+	return s
+}
+
+func (s *scrubber) replace(old, new string) {
+	if len(old) < 4 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.replacements[old] = new
+}
+
+// learn records the account and identity found in an IAM token response so
+// that they are replaced in this and every following interaction
+func (s *scrubber) learn(body string) {
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal([]byte(body), &token); err != nil || token.AccessToken == "" {
+		return
+	}
+	claims := parseJWTClaims(token.AccessToken)
+	if claims == nil {
+		return
+	}
+	if account, ok := claims["account"].(map[string]interface{}); ok {
+		if bss, ok := account["bss"].(string); ok {
+			s.replace(bss, AccountID)
+		}
+	}
+	for _, k := range []string{"iam_id", "id"} {
+		if id, ok := claims[k].(string); ok {
+			s.replace(id, IAMID)
+		}
+	}
+	for _, k := range []string{"sub", "email"} {
+		if id, ok := claims[k].(string); ok {
+			s.replace(id, "replay@ibm.com")
+		}
+	}
+}
+
+func (s *scrubber) text(text string) string {
+	text = jwtPattern.ReplaceAllString(text, AccessToken)
+	text = jsonFieldPattern.ReplaceAllStringFunc(text, func(field string) string {
+		m := jsonFieldPattern.FindStringSubmatch(field)
+		value := Redacted
+		if m[1] == "access_token" || m[1] == "uaa_token" {
+			value = AccessToken
+		}
+		return `"` + m[1] + `"` + m[2] + ":" + m[3] + `"` + value + `"`
+	})
+	text = formFieldPattern.ReplaceAllString(text, "${1}${2}="+Redacted)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for old, new := range s.replacements {
+		text = strings.Replace(text, old, new, -1)
+	}
+	return text
+}
+
+func (s *scrubber) headers(h http.Header) http.Header {
+	out := http.Header{}
+	for k, v := range h {
+		out[k] = append([]string(nil), v...)
+	}
+	for _, k := range volatileHeaders {
+		out.Del(k)
+	}
+	for _, k := range sensitiveHeaders {
+		if out.Get(k) != "" {
+			out.Set(k, Redacted)
+		}
+	}
+	for _, v := range out {
+		for i := range v {
+			v[i] = s.text(v[i])
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func (s *scrubber) interaction(i *Interaction) {
+	s.learn(i.Response.Body)
+	i.Request.Path = s.text(i.Request.Path)
+	i.Request.Query = s.text(i.Request.Query)
+	i.Request.Headers = s.headers(i.Request.Headers)
+	i.Request.Body = s.text(i.Request.Body)
+	i.Response.Headers = s.headers(i.Response.Headers)
+	i.Response.Body = s.text(i.Response.Body)
+}
+
+func fakeJWT(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(header) + "." + enc.EncodeToString(payload) + "." + enc.EncodeToString([]byte("replay"))
+}
+
+func parseJWTClaims(token string) map[string]interface{} {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil
+	}
+	return claims
+}
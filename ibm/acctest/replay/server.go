@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package replay records the HTTP interactions of acceptance tests in cassettes
+// and replays them from a local server, so that the tests can run without
+// credentials or network access.
+//
+// Every service endpoint is pointed at the local server with the environment
+// variables that override it, for example
+// IBMCLOUD_IS_NG_API_ENDPOINT=http://127.0.0.1:41234/IBMCLOUD_IS_NG_API_ENDPOINT.
+// While recording, the server forwards each request to the real endpoint and
+// stores the scrubbed exchange. While replaying, it answers from the cassette.
+package replay
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mode selects whether a Server records or replays a cassette
+type Mode string
+
+const (
+	ModeRecord Mode = "record"
+	ModeReplay Mode = "replay"
+)
+
+// Environment variables holding the credentials that are scrubbed from cassettes
+var credentialEnvs = []string{
+	"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY",
+	"IAAS_CLASSIC_API_KEY", "SL_API_KEY", "SOFTLAYER_API_KEY",
+	"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN", "IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN",
+}
+
+// Server is a local HTTP server that records or replays a cassette
+type Server struct {
+	mode      Mode
+	path      string
+	cassette  *Cassette
+	upstreams map[string]string
+	scrubber  *scrubber
+	server    *httptest.Server
+	client    *http.Client
+
+	mu     sync.Mutex
+	errors []string
+}
+
+// NewServer starts a server for the cassette at path. In ModeReplay the
+// cassette must exist, in ModeRecord it is written by Close.
+func NewServer(mode Mode, path, region string) (*Server, error) {
+	s := &Server{
+		mode: mode,
+		path: path,
+		client: &http.Client{
+			Timeout: 5 * time.Minute,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+	switch mode {
+	case ModeReplay:
+		c, err := LoadCassette(path)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error loading cassette %s: %s", path, err)
+		}
+		s.cassette = c
+	case ModeRecord:
+		var secrets []string
+		for _, env := range credentialEnvs {
+			if v := os.Getenv(env); v != "" {
+				secrets = append(secrets, v)
+			}
+		}
+		s.scrubber = newScrubber(secrets...)
+		s.upstreams = upstreams(region)
+		s.cassette = &Cassette{
+			Seed:   time.Now().UnixNano(),
+			Region: region,
+		}
+	default:
+		return nil, fmt.Errorf("[ERROR] Unknown replay mode %q, expected %q or %q", mode, ModeRecord, ModeReplay)
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s, nil
+}
+
+// Seed returns the seed for math/rand that makes the random names used by the
+// test match the cassette
+func (s *Server) Seed() int64 {
+	return s.cassette.Seed
+}
+
+// Region returns the region the cassette was recorded in
+func (s *Server) Region() string {
+	return s.cassette.Region
+}
+
+// Endpoints returns the environment variables that point every service
+// endpoint at the server
+func (s *Server) Endpoints() map[string]string {
+	env := make(map[string]string, len(endpoints))
+	for k := range endpoints {
+		env[k] = s.server.URL + "/" + k
+	}
+	return env
+}
+
+// Close stops the server and, when recording, saves the cassette. It returns
+// an error describing the requests that could not be recorded or replayed.
+func (s *Server) Close() error {
+	s.server.Close()
+	if s.mode == ModeRecord {
+		if err := s.cassette.Save(s.path); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.errors) > 0 {
+		return fmt.Errorf("[ERROR] %d request(s) failed in %s mode:\n%s", len(s.errors), s.mode, strings.Join(s.errors, "\n"))
+	}
+	return nil
+}
+
+func (s *Server) fail(w http.ResponseWriter, format string, a ...interface{}) {
+	msg := fmt.Sprintf(format, a...)
+	s.mu.Lock()
+	s.errors = append(s.errors, msg)
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotImplemented)
+	fmt.Fprintf(w, `{"errors":[{"code":"replay_error","message":%q}]}`, msg)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint, path := splitEndpoint(r.URL.EscapedPath())
+	if _, ok := endpoints[endpoint]; !ok {
+		s.fail(w, "%s %s does not name a known endpoint", r.Method, r.URL.Path)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		s.fail(w, "%s %s: error reading request body: %s", r.Method, r.URL.Path, err)
+		return
+	}
+
+	var i *Interaction
+	if s.mode == ModeRecord {
+		i, err = s.record(r, endpoint, path, body)
+		if err != nil {
+			s.fail(w, "%s %s: %s", r.Method, r.URL.Path, err)
+			return
+		}
+	} else {
+		i = s.cassette.next(r.Method, endpoint, path, r.URL.RawQuery)
+		if i == nil {
+			s.fail(w, "no interaction recorded for %s %s%s?%s", r.Method, endpoint, path, r.URL.RawQuery)
+			return
+		}
+	}
+	for k, v := range i.Response.Headers {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(i.Response.StatusCode)
+	w.Write([]byte(i.Response.Body))
+}
+
+// record forwards the request to the real endpoint and adds the scrubbed
+// exchange to the cassette. The unscrubbed response is returned to the caller.
+func (s *Server) record(r *http.Request, endpoint, path string, body []byte) (*Interaction, error) {
+	target := strings.TrimSuffix(s.upstreams[endpoint], "/") + path
+	if r.URL.RawQuery != "" {
+		target += "?" + r.URL.RawQuery
+	}
+	req, err := http.NewRequest(r.Method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range r.Header {
+		req.Header[k] = v
+	}
+	// Let the transport negotiate compression so that bodies are stored in clear
+	req.Header.Del("Accept-Encoding")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	headers := resp.Header.Clone()
+	headers.Del("Content-Length")
+	headers.Del("Content-Encoding")
+
+	live := &Interaction{
+		Response: Response{StatusCode: resp.StatusCode, Headers: headers, Body: string(respBody)},
+	}
+	recorded := &Interaction{
+		Request: Request{
+			Method:   r.Method,
+			Endpoint: endpoint,
+			Path:     path,
+			Query:    r.URL.RawQuery,
+			Headers:  r.Header,
+			Body:     string(body),
+		},
+		Response: Response{StatusCode: resp.StatusCode, Headers: headers, Body: string(respBody)},
+	}
+	s.scrubber.interaction(recorded)
+	s.cassette.add(recorded)
+	return live, nil
+}
+
+// splitEndpoint splits /IBMCLOUD_IS_NG_API_ENDPOINT/vpcs into the endpoint
+// variable and the path relative to the endpoint
+func splitEndpoint(p string) (string, string) {
+	p = strings.TrimPrefix(p, "/")
+	if i := strings.Index(p, "/"); i >= 0 {
+		return p[:i], p[i:]
+	}
+	return p, ""
+}
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testAPIKey  = "abcdefghijklmnopqrstuvwxyz0123456789ABCDEF"
+	testAccount = "1234567890abcdef1234567890abcdef"
+)
+
+func testUpstream(t *testing.T) *httptest.Server {
+	token := fakeJWT(map[string]interface{}{
+		"iam_id":  "IBMid-550000AAAA",
+		"account": map[string]interface{}{"bss": testAccount},
+		"exp":     4102444800,
+	})
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/identity/token":
+			r.ParseForm()
+			if r.Form.Get("apikey") != testAPIKey {
+				t.Errorf("upstream got apikey %q", r.Form.Get("apikey"))
+			}
+			fmt.Fprintf(w, `{"access_token":"%s","refresh_token":"secret-refresh-token","expiration":4102444800}`, token)
+		case "/v1/vpcs":
+			if r.Header.Get("Authorization") != "Bearer "+token {
+				t.Errorf("upstream got Authorization %q", r.Header.Get("Authorization"))
+			}
+			fmt.Fprintf(w, `{"vpcs":[{"id":"r006-1","crn":"crn:v1:bluemix:public:is:us-south:a/%s::vpc:r006-1"}]}`, testAccount)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func get(t *testing.T, target string) (int, string) {
+	resp, err := http.Get(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func post(t *testing.T, target string, form url.Values) (int, string) {
+	resp, err := http.PostForm(target, form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func setTestEnv(t *testing.T, k, v string) {
+	old, ok := os.LookupEnv(k)
+	os.Setenv(k, v)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(k, old)
+		} else {
+			os.Unsetenv(k)
+		}
+	})
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	upstream := testUpstream(t)
+	defer upstream.Close()
+	setTestEnv(t, "IC_API_KEY", testAPIKey)
+	setTestEnv(t, "IBMCLOUD_IAM_API_ENDPOINT", upstream.URL)
+	setTestEnv(t, "IBMCLOUD_IS_NG_API_ENDPOINT", upstream.URL+"/v1")
+
+	path := filepath.Join(t.TempDir(), "cassettes", "TestRecordAndReplay.json")
+
+	// Record
+	recorder, err := NewServer(ModeRecord, path, "us-south")
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := recorder.Endpoints()
+	_, tokenResp := post(t, env["IBMCLOUD_IAM_API_ENDPOINT"]+"/identity/token", url.Values{"apikey": {testAPIKey}, "grant_type": {"urn:ibm:params:oauth:grant-type:apikey"}})
+	if !strings.Contains(tokenResp, "secret-refresh-token") {
+		t.Fatalf("the live response must not be scrubbed: %s", tokenResp)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal([]byte(tokenResp), &token); err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest("GET", env["IBMCLOUD_IS_NG_API_ENDPOINT"]+"/vpcs?version=2022-06-01&generation=2", nil)
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("recording: unexpected status %d", resp.StatusCode)
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{testAPIKey, testAccount, "secret-refresh-token", "IBMid-550000AAAA", token.AccessToken} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q", secret)
+		}
+	}
+
+	// Replay, with the upstream gone
+	upstream.Close()
+	player, err := NewServer(ModeReplay, path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if player.Seed() != recorder.Seed() || player.Region() != "us-south" {
+		t.Fatalf("seed and region must be replayed")
+	}
+	env = player.Endpoints()
+	status, body := post(t, env["IBMCLOUD_IAM_API_ENDPOINT"]+"/identity/token", url.Values{"apikey": {Redacted}})
+	if status != http.StatusOK || !strings.Contains(body, AccessToken) {
+		t.Fatalf("replayed token response: %d %s", status, body)
+	}
+	for i := 0; i < 2; i++ {
+		status, body = get(t, env["IBMCLOUD_IS_NG_API_ENDPOINT"]+"/vpcs?generation=2&version=2022-06-01")
+		if status != http.StatusOK || !strings.Contains(body, "a/"+AccountID+"::vpc:r006-1") {
+			t.Fatalf("replayed vpcs response: %d %s", status, body)
+		}
+	}
+	if err := player.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReplayUnrecordedRequest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.json")
+	if err := (&Cassette{Region: "us-south"}).Save(path); err != nil {
+		t.Fatal(err)
+	}
+	player, err := NewServer(ModeReplay, path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, _ := get(t, player.Endpoints()["IBMCLOUD_TG_API_ENDPOINT"]+"/transit_gateways")
+	if status != http.StatusNotImplemented {
+		t.Fatalf("expected %d, got %d", http.StatusNotImplemented, status)
+	}
+	if err := player.Close(); err == nil || !strings.Contains(err.Error(), "IBMCLOUD_TG_API_ENDPOINT/transit_gateways") {
+		t.Fatalf("expected the unrecorded request to be reported, got %v", err)
+	}
+}
+
+func TestScrubber(t *testing.T) {
+	s := newScrubber(testAPIKey)
+	cases := map[string]string{
+		"apikey=" + testAPIKey + "&grant_type=x":    "apikey=REDACTED&grant_type=x",
+		`{"password": "hunter2", "name": "a"}`:      `{"password": "REDACTED", "name": "a"}`,
+		`{"refresh_token":"abc","uaa_token":"xyz"}`: `{"refresh_token":"REDACTED","uaa_token":"` + AccessToken + `"}`,
+		"key " + testAPIKey:                         "key REDACTED",
+	}
+	for in, want := range cases {
+		if got := s.text(in); got != want {
+			t.Errorf("scrub(%q) = %q, want %q", in, got, want)
+		}
+	}
+	h := s.headers(http.Header{"Authorization": {"Bearer x"}, "Date": {"today"}, "Content-Type": {"application/json"}})
+	if h.Get("Authorization") != Redacted || h.Get("Date") != "" || h.Get("Content-Type") != "application/json" {
+		t.Errorf("unexpected headers %v", h)
+	}
+}
@@ -10,10 +10,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"net"
 	gohttp "net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -103,7 +103,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/managementv2"
 	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/bluemix-go/authentication"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
@@ -117,9 +116,6 @@ import (
 	"github.com/IBM/scc-go-sdk/v3/posturemanagementv1"
 )
 
-// RetryAPIDelay - retry api delay
-const RetryAPIDelay = 5 * time.Second
-
 //BluemixRegion ...
 var BluemixRegion string
 
@@ -161,12 +157,12 @@ type Config struct {
 	SoftLayerAPIKey string
 
 	//Retry Count for API calls
-	//Unexposed in the schema at this point as they are used only during session creation for a few calls
-	//When sdk implements it we an expose them for expected behaviour
-	//https://github.com/softlayer/softlayer-go/issues/41
 	RetryCount int
-	//Constant Retry Delay for API calls
-	RetryDelay time.Duration
+	//Exponential backoff between retries of API calls, see RetryPolicy
+	RetryBaseDelay       time.Duration
+	RetryMaxDelay        time.Duration
+	RetryJitter          bool
+	RetryableStatusCodes []int
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -190,8 +186,25 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// Endpoints set in the endpoints block, keyed by the attribute name
+	Endpoints map[string]string
+
+	// Tags added to every taggable resource managed by the provider
+	DefaultTags       []string
+	DefaultAccessTags []string
+
+	// Whether quotas and capacity are checked at plan time, one of the
+	// CapacityChecks constants
+	CapacityChecks string
 }
 
+// Values of the capacity_checks provider argument
+const (
+	CapacityChecksOff   = "off"
+	CapacityChecksError = "error"
+)
+
 //Session stores the information required for communication with the SoftLayer and Bluemix API
 type Session struct {
 	// SoftLayerSesssion is the the SoftLayer session used to connect to the SoftLayer API
@@ -225,6 +238,12 @@ type ClientSession interface {
 	ResourceControllerAPI() (controller.ResourceControllerAPI, error)
 	ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error)
 	SoftLayerSession() *slsession.Session
+	DefaultTags() []string
+	DefaultAccessTags() []string
+	RetryPolicy() *RetryPolicy
+	CapacityChecks() string
+	EgressContext() EgressContext
+	EndpointFallBack(env, defaultValue string) string
 	IBMPISession() (*ibmpisession.IBMPISession, error)
 	UserManagementAPI() (usermanagementv2.UserManagementAPI, error)
 	PushServiceV1() (*pushservicev1.PushServiceV1, error)
@@ -296,6 +315,12 @@ type ClientSession interface {
 
 type clientSession struct {
 	session *Session
+	config  *Config
+
+	// Shared by the service clients, which are configured on first use
+	fileMap       map[string]interface{}
+	iamURL        string
+	authenticator core.Authenticator
 
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
@@ -605,239 +630,415 @@ type clientSession struct {
 	// CD Tekton Pipeline
 	cdTektonPipelineClient    *cdtektonpipelinev2.CdTektonPipelineV2
 	cdTektonPipelineClientErr error
+
+	// Guards the one-time configuration of each service client
+	functionConfigOnce                 sync.Once
+	accountV1ConfigOnce                sync.Once
+	accountConfigOnce                  sync.Once
+	cfConfigOnce                       sync.Once
+	csConfigOnce                       sync.Once
+	csv2ConfigOnce                     sync.Once
+	hpcsEndpointOnce                   sync.Once
+	kpOnce                             sync.Once
+	kmsOnce                            sync.Once
+	ukoClientOnce                      sync.Once
+	appidOnce                          sync.Once
+	contextBasedRestrictionsClientOnce sync.Once
+	catalogManagementClientOnce        sync.Once
+	atrackerClientOnce                 sync.Once
+	atrackerClientV2Once               sync.Once
+	adminServiceApiClientOnce          sync.Once
+	schematicsClientOnce               sync.Once
+	vpcOnce                            sync.Once
+	pushServiceClientOnce              sync.Once
+	eventNotificationsApiClientOnce    sync.Once
+	appConfigurationClientOnce         sync.Once
+	containerRegistryClientOnce        sync.Once
+	cosConfigOnce                      sync.Once
+	globalSearchConfigOnce             sync.Once
+	globalTaggingConfigOnce            sync.Once
+	globalTaggingConfigOnceV1          sync.Once
+	icdConfigOnce                      sync.Once
+	cloudDatabasesClientOnce           sync.Once
+	resourceCatalogConfigOnce          sync.Once
+	resourceManagementConfigOncev2     sync.Once
+	resourceControllerConfigOnce       sync.Once
+	resourceControllerConfigOncev2     sync.Once
+	userManagementOnce                 sync.Once
+	certManagementOnce                 sync.Once
+	functionIAMNamespaceOnce           sync.Once
+	apigatewayOnce                     sync.Once
+	ibmpiConfigOnce                    sync.Once
+	pDNSOnce                           sync.Once
+	directlinkOnce                     sync.Once
+	dlProviderOnce                     sync.Once
+	transitgatewayOnce                 sync.Once
+	cisZonesOnce                       sync.Once
+	cisDNSOnce                         sync.Once
+	cisDNSBulkOnce                     sync.Once
+	cisGLBPoolOnce                     sync.Once
+	cisGLBOnce                         sync.Once
+	cisGLBHealthCheckOnce              sync.Once
+	cisIPOnce                          sync.Once
+	cisRLOnce                          sync.Once
+	cisAlertsOnce                      sync.Once
+	cisPageRuleOnce                    sync.Once
+	cisEdgeFunctionOnce                sync.Once
+	cisSSLOnce                         sync.Once
+	cisWAFPackageOnce                  sync.Once
+	cisDomainSettingsOnce              sync.Once
+	cisRoutingOnce                     sync.Once
+	cisWAFGroupOnce                    sync.Once
+	cisCacheOnce                       sync.Once
+	cisCustomPageOnce                  sync.Once
+	cisAccessRuleOnce                  sync.Once
+	cisUARuleOnce                      sync.Once
+	cisLockdownOnce                    sync.Once
+	cisRangeAppOnce                    sync.Once
+	cisWAFRuleOnce                     sync.Once
+	cisLogpushJobsOnce                 sync.Once
+	cisMtlsOnce                        sync.Once
+	cisWebhooksOnce                    sync.Once
+	cisFiltersOnce                     sync.Once
+	cisFirewallRulesOnce               sync.Once
+	cisOriginAuthPullOnce              sync.Once
+	iamIdentityOnce                    sync.Once
+	iamPolicyManagementOnce            sync.Once
+	iamAccessGroupsOnce                sync.Once
+	resourceManagerOnce                sync.Once
+	ibmCloudShellClientOnce            sync.Once
+	enterpriseManagementClientOnce     sync.Once
+	resourceControllerOnce             sync.Once
+	secretsManagerClientOnce           sync.Once
+	satelliteClientOnce                sync.Once
+	satelliteLinkClientOnce            sync.Once
+	esSchemaRegistryOnce               sync.Once
+	configServiceApiClientOnce         sync.Once
+	postureManagementClientOnce        sync.Once
+	postureManagementClientOncev2      sync.Once
+	cdToolchainClientOnce              sync.Once
+	cdTektonPipelineClientOnce         sync.Once
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.lazy(&session.appidOnce, session.configureAppIDAPI)
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.lazy(&session.catalogManagementClientOnce, session.configureCatalogManagementV1)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.lazy(&sess.accountConfigOnce, sess.configureBluemixAcccountAPI)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.lazy(&sess.accountV1ConfigOnce, sess.configureBluemixAcccountv1API)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.lazy(&sess.csConfigOnce, sess.configureContainerAPI)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.lazy(&sess.csv2ConfigOnce, sess.configureVpcContainerAPI)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.lazy(&session.containerRegistryClientOnce, session.configureContainerRegistryV1)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.lazy(&sess.schematicsClientOnce, sess.configureSchematicsV1)
 	return sess.schematicsClient, sess.schematicsClientErr
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.lazy(&sess.functionConfigOnce, sess.configureFunctionClient)
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.lazy(&sess.globalSearchConfigOnce, sess.configureGlobalSearchAPI)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.lazy(&sess.globalTaggingConfigOnce, sess.configureGlobalTaggingAPI)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.lazy(&sess.globalTaggingConfigOnceV1, sess.configureGlobalTaggingAPIv1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.lazy(&sess.hpcsEndpointOnce, sess.configureHpcsEndpointAPI)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.lazy(&session.ukoClientOnce, session.configureUkoV4)
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.lazy(&sess.userManagementOnce, sess.configureUserManagementAPI)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.lazy(&sess.iamPolicyManagementOnce, sess.configureIAMPolicyManagementV1API)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.lazy(&sess.iamAccessGroupsOnce, sess.configureIAMAccessGroupsV2)
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.lazy(&session.ibmCloudShellClientOnce, session.configureIBMCloudShellV1)
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.lazy(&sess.icdConfigOnce, sess.configureICDAPI)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.lazy(&session.cloudDatabasesClientOnce, session.configureCloudDatabasesV5)
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.lazy(&sess.cfConfigOnce, sess.configureMccpAPI)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.lazy(&sess.resourceCatalogConfigOnce, sess.configureResourceCatalogAPI)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.lazy(&sess.resourceManagementConfigOncev2, sess.configureResourceManagementAPIv2)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.lazy(&sess.resourceControllerConfigOnce, sess.configureResourceControllerAPI)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.lazy(&sess.resourceControllerConfigOncev2, sess.configureResourceControllerAPIV2)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
+// DefaultTags returns the user tags configured at the provider level
+func (sess *clientSession) DefaultTags() []string {
+	return sess.config.DefaultTags
+}
+
+// DefaultAccessTags returns the access tags configured at the provider level
+func (sess *clientSession) DefaultAccessTags() []string {
+	return sess.config.DefaultAccessTags
+}
+
+// RetryPolicy returns the retry policy configured at the provider level
+func (sess *clientSession) RetryPolicy() *RetryPolicy {
+	return sess.config.RetryPolicy()
+}
+
+// CapacityChecks returns whether quotas and capacity are checked at plan time
+func (sess *clientSession) CapacityChecks() string {
+	if sess.config.CapacityChecks == "" {
+		return CapacityChecksOff
+	}
+	return sess.config.CapacityChecks
+}
+
+// EgressContext describes where the provider calls IBM Cloud from, as seen
+// by context-based restrictions
+type EgressContext struct {
+	IPAddress    string
+	VPC          string
+	EndpointType string
+}
+
+// EgressContext returns the egress context of the provider. The endpoint type
+// follows the visibility of the provider; the public IP address and the CRN of
+// the VPC the provider runs in are only known when they are set with the
+// IC_EGRESS_IP_ADDRESS and IC_EGRESS_VPC_CRN environment variables
+func (sess *clientSession) EgressContext() EgressContext {
+	egress := EgressContext{
+		IPAddress:    EnvFallBack([]string{"IC_EGRESS_IP_ADDRESS", "IBMCLOUD_EGRESS_IP_ADDRESS"}, ""),
+		VPC:          EnvFallBack([]string{"IC_EGRESS_VPC_CRN", "IBMCLOUD_EGRESS_VPC_CRN"}, ""),
+		EndpointType: "public",
+	}
+	if sess.config.Visibility == "private" {
+		egress.EndpointType = "private"
+	}
+	return egress
+}
+
 // CertManagementAPI provides Certificate  management APIs ...
-func (sess clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+func (sess *clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+	sess.lazy(&sess.certManagementOnce, sess.configureCertificateManagerAPI)
 	return sess.certManagementAPI, sess.certManagementErr
 }
 
 //apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.lazy(&sess.apigatewayOnce, sess.configureAPIGateway)
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.lazy(&session.pushServiceClientOnce, session.configurePushServiceV1)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.lazy(&session.eventNotificationsApiClientOnce, session.configureEventNotificationsApiV1)
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.lazy(&session.appConfigurationClientOnce, session.configureAppConfigurationV1)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.lazy(&sess.kpOnce, sess.configureKeyProtectAPI)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.lazy(&sess.kmsOnce, sess.configureKeyManagementAPI)
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:  EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:  sess.config.endpointFallBack("IBMCLOUD_KP_API_ENDPOINT", sess.kmsAPI.Config.BaseURL),
 				APIKey:   sess.kmsAPI.Config.APIKey, //pragma: allowlist secret
 				Verbose:  kp.VerboseFailOnly,
 				TokenURL: sess.kmsAPI.Config.TokenURL,
 			}
 		} else {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:       sess.config.endpointFallBack("IBMCLOUD_KP_API_ENDPOINT", sess.kmsAPI.Config.BaseURL),
 				Authorization: sess.session.BluemixSession.Config.IAMAccessToken, //pragma: allowlist secret
 				Verbose:       kp.VerboseFailOnly,
 				TokenURL:      sess.kmsAPI.Config.TokenURL,
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.config.keyProtectTransport())
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return nil, fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.lazy(&sess.vpcOnce, sess.configureVpcV1API)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.lazy(&sess.directlinkOnce, sess.configureDirectlinkV1API)
 	return sess.directlinkAPI, sess.directlinkErr
 }
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.lazy(&sess.dlProviderOnce, sess.configureDirectlinkProviderV2API)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.lazy(&sess.cosConfigOnce, sess.configureCosConfigV1API)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.lazy(&sess.transitgatewayOnce, sess.configureTransitGatewayV1API)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.lazy(&sess.ibmpiConfigOnce, sess.configureIBMPISession)
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.lazy(&sess.pDNSOnce, sess.configurePrivateDNSClientSession)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.lazy(&sess.functionIAMNamespaceOnce, sess.configureFunctionIAMNamespaceAPI)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.lazy(&sess.cisZonesOnce, sess.configureCisZonesV1ClientSession)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -845,7 +1046,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.lazy(&sess.cisDNSOnce, sess.configureCisDNSRecordClientSession)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -853,7 +1055,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.lazy(&sess.cisDNSBulkOnce, sess.configureCisDNSRecordBulkClientSession)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -861,7 +1064,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.lazy(&sess.cisGLBPoolOnce, sess.configureCisGLBPoolClientSession)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -869,7 +1073,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.lazy(&sess.cisGLBOnce, sess.configureCisGLBClientSession)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -877,7 +1082,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.lazy(&sess.cisGLBHealthCheckOnce, sess.configureCisGLBHealthCheckClientSession)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -885,7 +1091,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.lazy(&sess.cisRLOnce, sess.configureCisRLClientSession)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -893,7 +1100,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.lazy(&sess.cisIPOnce, sess.configureCisIPClientSession)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -901,7 +1109,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.lazy(&sess.cisPageRuleOnce, sess.configureCisPageRuleClientSession)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -909,7 +1118,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.lazy(&sess.cisEdgeFunctionOnce, sess.configureCisEdgeFunctionClientSession)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -917,7 +1127,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.lazy(&sess.cisSSLOnce, sess.configureCisSSLClientSession)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -925,7 +1136,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.lazy(&sess.cisWAFPackageOnce, sess.configureCisWAFPackageClientSession)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -933,7 +1145,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.lazy(&sess.cisDomainSettingsOnce, sess.configureCisDomainSettingsClientSession)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -941,7 +1154,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.lazy(&sess.cisAlertsOnce, sess.configureCisAlertsSession)
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -949,7 +1163,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.lazy(&sess.cisRoutingOnce, sess.configureCisRoutingClientSession)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -957,7 +1172,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.lazy(&sess.cisWAFGroupOnce, sess.configureCisWAFGroupClientSession)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -965,7 +1181,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.lazy(&sess.cisCacheOnce, sess.configureCisCacheClientSession)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -973,7 +1190,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.lazy(&sess.cisCustomPageOnce, sess.configureCisCustomPageClientSession)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -981,7 +1199,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.lazy(&sess.cisAccessRuleOnce, sess.configureCisAccessRuleClientSession)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -989,7 +1208,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.lazy(&sess.cisUARuleOnce, sess.configureCisUARuleClientSession)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -997,7 +1217,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.lazy(&sess.cisLockdownOnce, sess.configureCisLockdownClientSession)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1005,7 +1226,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.lazy(&sess.cisRangeAppOnce, sess.configureCisRangeAppClientSession)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1013,7 +1235,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.lazy(&sess.cisWAFRuleOnce, sess.configureCisWAFRuleClientSession)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1021,7 +1244,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.lazy(&sess.cisOriginAuthPullOnce, sess.configureCisOrigAuthSession)
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1029,43 +1253,51 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.lazy(&sess.iamIdentityOnce, sess.configureIAMIdentityV1API)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.lazy(&sess.resourceManagerOnce, sess.configureResourceManagerV2API)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.lazy(&session.enterpriseManagementClientOnce, session.configureEnterpriseManagementV1)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.lazy(&sess.resourceControllerOnce, sess.configureResourceControllerV2API)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
 // SecretsManager Session
-func (session clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+func (session *clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+	session.lazy(&session.secretsManagerClientOnce, session.configureSecretsManagerV1)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.lazy(&session.satelliteLinkClientOnce, session.configureSatellitLinkClientSession)
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.lazy(&sess.satelliteClientOnce, sess.configureSatelliteClientSession)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.lazy(&sess.cisLogpushJobsOnce, sess.configureCisLogpushJobsSession)
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1073,7 +1305,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.lazy(&sess.cisMtlsOnce, sess.configureCisMtlsSession)
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1081,7 +1314,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.lazy(&sess.cisWebhooksOnce, sess.configureCisWebhookSession)
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1089,7 +1323,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.lazy(&sess.cisFiltersOnce, sess.configureCisFiltersSession)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1097,7 +1332,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.lazy(&sess.cisFirewallRulesOnce, sess.configureCisFirewallRulesSession)
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1105,29 +1341,35 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+func (session *clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
+	session.lazy(&session.atrackerClientOnce, session.configureAtrackerV1)
 	return session.atrackerClient, session.atrackerClientErr
 }
 
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.lazy(&session.atrackerClientV2Once, session.configureAtrackerV2)
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.lazy(&session.esSchemaRegistryOnce, session.configureESschemaRegistrySession)
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
 //Security and Compliance center Admin API
-func (session clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+func (session *clientSession) AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error) {
+	session.lazy(&session.adminServiceApiClientOnce, session.configureAdminServiceApiV1)
 	return session.adminServiceApiClient, session.adminServiceApiClientErr
 }
 
-func (session clientSession) ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error) {
+func (session *clientSession) ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error) {
+	session.lazy(&session.configServiceApiClientOnce, session.configureConfigurationGovernanceV1)
 	return session.configServiceApiClient, session.configServiceApiClientErr
 }
 
 // Security and Compliance center Posture Management
-func (session clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+func (session *clientSession) PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error) {
+	session.lazy(&session.postureManagementClientOnce, session.configurePostureManagementV1)
 	if session.postureManagementClientErr != nil {
 		return session.postureManagementClient, session.postureManagementClientErr
 	}
@@ -1135,7 +1377,8 @@ func (session clientSession) PostureManagementV1() (*posturemanagementv1.Posture
 }
 
 //Security and Compliance center Posture Management v2
-func (session clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+func (session *clientSession) PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error) {
+	session.lazy(&session.postureManagementClientOncev2, session.configurePostureManagementV2)
 	if session.postureManagementClientErrv2 != nil {
 		return session.postureManagementClientv2, session.postureManagementClientErrv2
 	}
@@ -1143,29 +1386,36 @@ func (session clientSession) PostureManagementV2() (*posturemanagementv2.Posture
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.lazy(&session.contextBasedRestrictionsClientOnce, session.configureContextBasedRestrictionsV1)
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.lazy(&session.cdToolchainClientOnce, session.configureCdToolchainV2)
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.lazy(&session.cdTektonPipelineClientOnce, session.configureCdTektonPipelineV2)
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
+	if err := ValidateEndpoints(c.Endpoints); err != nil {
+		return nil, err
+	}
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
+	session := &clientSession{
 		session: sess,
+		config:  c,
 	}
 
 	if sess.BluemixSession == nil {
@@ -1255,55 +1505,31 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	if sess.BluemixSession.Config.BluemixAPIKey != "" {
-		err = authenticateAPIKey(sess.BluemixSession)
+		err = c.RetryPolicy().Retry(func() error {
+			return authenticateAPIKey(sess.BluemixSession)
+		})
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying IAM Authentication %d", count)
-				err = authenticateAPIKey(sess.BluemixSession)
-			}
-			if err != nil {
-				session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
-			}
+			session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
+			session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 		}
-		err = authenticateCF(sess.BluemixSession)
+		err = c.RetryPolicy().Retry(func() error {
+			return authenticateCF(sess.BluemixSession)
+		})
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying CF Authentication %d", count)
-				err = authenticateCF(sess.BluemixSession)
-			}
-			if err != nil {
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
-			}
+			session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 		}
 	}
 
 	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
-		err := RefreshToken(sess.BluemixSession)
+		err := c.RetryPolicy().Retry(func() error {
+			return RefreshToken(sess.BluemixSession)
+		})
 		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying refresh token %d", count)
-				err = RefreshToken(sess.BluemixSession)
-			}
-			if err != nil {
-				return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
-			}
+			return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
 		}
 
 	}
-	userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryCount, c.RetryDelay)
+	userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryPolicy(), 0)
 	if err != nil {
 		session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching account user details: %q", err)
 	}
@@ -1314,8 +1540,6 @@ func (c *Config) ClientSession() (interface{}, error) {
 		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
 	}
 
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
-
 	BluemixRegion = sess.BluemixSession.Config.Region
 	var fileMap map[string]interface{}
 	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
@@ -1333,41 +1557,157 @@ func (c *Config) ClientSession() (interface{}, error) {
 			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
 		}
 	}
+	session.fileMap = fileMap
+
+	iamURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	}
+	session.iamURL = iamURL
+
+	var authenticator core.Authenticator
+
+	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+		if c.BluemixAPIKey != "" {
+			authenticator = &core.IamAuthenticator{
+				ApiKey: c.BluemixAPIKey,
+				URL:    c.endpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamURL),
+				Client: c.RetryPolicy().Client(core.DefaultHTTPClient()),
+			}
+		} else {
+			// Construct the IamAuthenticator with the IAM refresh token.
+			authenticator = &core.IamAuthenticator{
+				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
+				ClientId:     "bx",
+				ClientSecret: "bx",
+				URL:          c.endpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamURL),
+				Client:       c.RetryPolicy().Client(core.DefaultHTTPClient()),
+			}
+		}
+	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
+		}
+	} else {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
+		}
+	}
+	session.authenticator = authenticator
+
+	if os.Getenv("TF_LOG") != "" {
+		logDestination := log.Writer()
+		goLogger := log.New(logDestination, "", log.LstdFlags)
+		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+	}
+	return session, nil
+}
+
+// lazy configures a service client the first time it is requested. When no
+// Bluemix session could be created the client errors have already been recorded
+// by ClientSession and nothing is configured.
+func (session *clientSession) lazy(once *sync.Once, configure func()) {
+	if session.bluemixSessionErr != nil {
+		return
+	}
+	once.Do(configure)
+}
+
+// cisEndpoint returns the endpoint shared by all the CIS service clients
+func (session *clientSession) cisEndpoint() string {
+	c := session.config
+	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		cisURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
+	}
+	return c.endpointFallBack("IBMCLOUD_CIS_API_ENDPOINT", cisURL)
+}
+
+// configureFunctionClient configures the client returned by FunctionClient
+func (session *clientSession) configureFunctionClient() {
+	sess := session.session
+
+	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+}
+
+// configureBluemixAcccountv1API configures the client returned by BluemixAcccountv1API
+func (session *clientSession) configureBluemixAcccountv1API() {
+	sess := session.session
+
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 	}
 	session.bmxAccountv1ServiceAPI = accv1API
+}
+
+// configureBluemixAcccountAPI configures the client returned by BluemixAcccountAPI
+func (session *clientSession) configureBluemixAcccountAPI() {
+	sess := session.session
 
 	accAPI, err := accountv2.New(sess.BluemixSession)
 	if err != nil {
 		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 	}
 	session.bmxAccountServiceAPI = accAPI
+}
+
+// configureMccpAPI configures the client returned by MccpAPI
+func (session *clientSession) configureMccpAPI() {
+	sess := session.session
 
 	cfAPI, err := mccpv2.New(sess.BluemixSession)
 	if err != nil {
 		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 	}
 	session.cfServiceAPI = cfAPI
+}
+
+// configureContainerAPI configures the client returned by ContainerAPI
+func (session *clientSession) configureContainerAPI() {
+	sess := session.session
 
 	clusterAPI, err := containerv1.New(sess.BluemixSession)
 	if err != nil {
 		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
 	session.csServiceAPI = clusterAPI
+}
+
+// configureVpcContainerAPI configures the client returned by VpcContainerAPI
+func (session *clientSession) configureVpcContainerAPI() {
+	sess := session.session
 
 	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 	}
 	session.csv2ServiceAPI = v2clusterAPI
+}
+
+// configureHpcsEndpointAPI configures the client returned by HpcsEndpointAPI
+func (session *clientSession) configureHpcsEndpointAPI() {
+	sess := session.session
 
 	hpcsAPI, err := hpcs.New(sess.BluemixSession)
 	if err != nil {
 		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 	}
 	session.hpcsEndpointAPI = hpcsAPI
+}
+
+// configureKeyProtectAPI configures the client returned by KeyProtectAPI
+func (session *clientSession) configureKeyProtectAPI() {
+	c := session.config
+	sess := session.session
+	fileMap := session.fileMap
 
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -1379,7 +1719,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	var options kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+			BaseURL: c.endpointFallBack("IBMCLOUD_KP_API_ENDPOINT", kpurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
@@ -1387,31 +1727,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	} else {
 		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+			BaseURL:       c.endpointFallBack("IBMCLOUD_KP_API_ENDPOINT", kpurl),
 			Authorization: sess.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpAPIclient, err := kp.New(options, c.keyProtectTransport())
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
+// configureKeyManagementAPI configures the KEY MANAGEMENT Service client
+func (session *clientSession) configureKeyManagementAPI() {
+	c := session.config
+	sess := session.session
+	fileMap := session.fileMap
+	iamURL := session.iamURL
 
-	// KEY MANAGEMENT Service
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
@@ -1422,54 +1757,34 @@ func (c *Config) ClientSession() (interface{}, error) {
 	var kmsOptions kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+			BaseURL: c.endpointFallBack("IBMCLOUD_KP_API_ENDPOINT", kmsurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			TokenURL: c.endpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamURL) + "/identity/token",
 		}
 
 	} else {
 		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+			BaseURL:       c.endpointFallBack("IBMCLOUD_KP_API_ENDPOINT", kmsurl),
 			Authorization: sess.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			TokenURL: c.endpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamURL) + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, c.keyProtectTransport())
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
-	}
+// configureUkoV4 configures the client returned by UkoV4
+func (session *clientSession) configureUkoV4() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating the service client.
 	ukoClientOptions := &ukov4.UkoV4Options{
@@ -1480,7 +1795,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.ukoClient.Service)
 		// Add custom header for analytics
 		session.ukoClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1488,8 +1803,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
 	}
+}
+
+// configureAppIDAPI configures the APPID Service client
+func (session *clientSession) configureAppIDAPI() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// APPID Service
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
@@ -1499,19 +1820,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	appIDClientOptions := &appid.AppIDManagementV4Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
+		URL:           c.endpointFallBack("IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", appIDEndpoint),
 	}
 	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
 	if err != nil {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(appIDClient.Service)
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.appidAPI = appIDClient
+}
+
+// configureContextBasedRestrictionsV1 configures the client returned by ContextBasedRestrictionsV1
+func (session *clientSession) configureContextBasedRestrictionsV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating Context Based Restrictions service client.
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
@@ -1523,14 +1852,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
+		URL:           c.endpointFallBack("IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", cbrURL),
 	}
 
 	// Construct the service client.
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.contextBasedRestrictionsClient.Service)
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1538,8 +1867,15 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
 	}
+}
+
+// configureCatalogManagementV1 configures the CATALOG MANAGEMENT Service client
+func (session *clientSession) configureCatalogManagementV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
-	// CATALOG MANAGEMENT Service
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
@@ -1548,7 +1884,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
 	}
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
+		URL:           c.endpointFallBack("IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", catalogManagementURL),
 		Authenticator: authenticator,
 	}
 	// Construct the service client.
@@ -1558,14 +1894,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.catalogManagementClient.Service)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureAtrackerV1 configures the ATRACKER Service client
+func (session *clientSession) configureAtrackerV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
-	// ATRACKER Service
 	var atrackerClientURL string
 	atrackerClientURL, err = atrackerv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
@@ -1585,7 +1928,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	atrackerClientOptions := &atrackerv1.AtrackerV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientURL),
+		URL:           c.endpointFallBack("IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientURL),
 	}
 	// Construct the service client.
 	session.atrackerClient, err = atrackerv1.NewAtrackerV1(atrackerClientOptions)
@@ -1594,13 +1937,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.atrackerClient != nil && session.atrackerClient.Service != nil {
 		// Enable retries for API calls
-		session.atrackerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.atrackerClient.Service)
 		// Add custom header for analytics
 		session.atrackerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// Version 2 Atracker
+}
+
+// configureAtrackerV2 configures the Version 2 Atracker client
+func (session *clientSession) configureAtrackerV2() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
+
 	var atrackerClientV2URL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		atrackerClientV2URL, err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
@@ -1618,12 +1969,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
+		URL:           c.endpointFallBack("IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientV2URL),
 	}
 	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
 	if err == nil {
 		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.atrackerClientV2.Service)
 		// Add custom header for analytics
 		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1631,8 +1982,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
 	}
+}
+
+// configureAdminServiceApiV1 configures the SCC ADMIN Service client
+func (session *clientSession) configureAdminServiceApiV1() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
-	// SCC ADMIN Service
 	var adminServiceApiClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		adminServiceApiClientURL, err = adminserviceapiv1.GetServiceURLForRegion("private." + c.Region)
@@ -1647,14 +2004,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	adminServiceApiClientOptions := &adminserviceapiv1.AdminServiceApiV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_ADMIN_API_ENDPOINT"}, adminServiceApiClientURL),
+		URL:           c.endpointFallBack("IBMCLOUD_SCC_ADMIN_API_ENDPOINT", adminServiceApiClientURL),
 	}
 
 	// Construct the service client.
 	session.adminServiceApiClient, err = adminserviceapiv1.NewAdminServiceApiV1(adminServiceApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.adminServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.adminServiceApiClient.Service)
 		// Add custom header for analytics
 		session.adminServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1662,8 +2019,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.adminServiceApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Admin Service API service: %q", err)
 	}
+}
+
+// configureSchematicsV1 configures the SCHEMATICS Service client
+func (session *clientSession) configureSchematicsV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// SCHEMATICS Service
 	schematicsEndpoint := "https://schematics.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1679,7 +2042,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		URL:           c.endpointFallBack("IBMCLOUD_SCHEMATICS_API_ENDPOINT", schematicsEndpoint),
 	}
 	// Construct the service client.
 	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
@@ -1688,14 +2051,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(schematicsClient.Service)
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.schematicsClient = schematicsClient
+}
+
+// configureVpcV1API configures the VPC Service client
+func (session *clientSession) configureVpcV1API() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// VPC Service
 	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
@@ -1704,7 +2073,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
 	}
 	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+		URL:           c.endpointFallBack("IBMCLOUD_IS_NG_API_ENDPOINT", vpcurl),
 		Authenticator: authenticator,
 	}
 	vpcclient, err := vpc.NewVpcV1(vpcoptions)
@@ -1712,14 +2081,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(vpcclient.Service)
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcAPI = vpcclient
+}
+
+// configurePushServiceV1 configures the PUSH NOTIFICATIONS Service client
+func (session *clientSession) configurePushServiceV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// PUSH NOTIFICATIONS Service
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
@@ -1728,7 +2103,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
 	}
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
+		URL:           c.endpointFallBack("IBMCLOUD_PUSH_API_ENDPOINT", pnurl),
 		Authenticator: authenticator,
 	}
 	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
@@ -1737,13 +2112,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(pnclient.Service)
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.pushServiceClient = pnclient
-	// event notifications
+}
+
+// configureEventNotificationsApiV1 configures the event notifications client
+func (session *clientSession) configureEventNotificationsApiV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
+
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 	if c.Visibility == "private" {
 		session.eventNotificationsApiClientErr = fmt.Errorf("Event Notifications Service does not support private endpoints")
@@ -1753,7 +2136,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
+		URL:           c.endpointFallBack("IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", enurl),
 	}
 	// Construct the service client.
 	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
@@ -1763,13 +2146,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.eventNotificationsApiClient.Service)
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureAppConfigurationV1 configures the APP CONFIGURATION Service client
+func (session *clientSession) configureAppConfigurationV1() {
+	c := session.config
+	authenticator := session.authenticator
 
-	// APP CONFIGURATION Service
 	if c.Visibility == "private" {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] App Configuration Service API doesnot support private endpoints")
 	}
@@ -1779,13 +2167,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(appConfigClient.Service)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 	}
+}
+
+// configureContainerRegistryV1 configures the CONTAINER REGISTRY Service client
+func (session *clientSession) configureContainerRegistryV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
 
-	// CONTAINER REGISTRY Service
 	// Construct an "options" struct for creating the service client.
 	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
@@ -1802,7 +2197,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+		URL:           c.endpointFallBack("IBMCLOUD_CR_API_ENDPOINT", containerRegistryClientURL),
 		Account:       core.StringPtr(userConfig.UserAccount),
 	}
 	// Construct the service client.
@@ -1812,41 +2207,65 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.containerRegistryClient.Service)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCosConfigV1API configures the OBJECT STORAGE Service client
+func (session *clientSession) configureCosConfigV1API() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// OBJECT STORAGE Service
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
 	}
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
+		URL:           c.endpointFallBack("IBMCLOUD_COS_CONFIG_ENDPOINT", cosconfigurl),
 	}
 	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
 	if err != nil {
 		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
+	} else {
+		cosconfigclient.Service.SetHTTPClient(c.RetryPolicy().Client(cosconfigclient.Service.Client))
 	}
 	session.cosConfigAPI = cosconfigclient
+}
+
+// configureGlobalSearchAPI configures the client returned by GlobalSearchAPI
+func (session *clientSession) configureGlobalSearchAPI() {
+	sess := session.session
 
 	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
 	if err != nil {
 		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	session.globalSearchServiceAPI = globalSearchAPI
-	// Global Tagging Bluemix-go
+}
+
+// configureGlobalTaggingAPI configures the Global Tagging Bluemix-go client
+func (session *clientSession) configureGlobalTaggingAPI() {
+	sess := session.session
+
 	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
 	if err != nil {
 		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
 	}
 	session.globalTaggingServiceAPI = globalTaggingAPI
+}
+
+// configureGlobalTaggingAPIv1 configures the GLOBAL TAGGING Service client
+func (session *clientSession) configureGlobalTaggingAPIv1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// GLOBAL TAGGING Service
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalTaggingRegion string
@@ -1861,7 +2280,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
 	}
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
+		URL:           c.endpointFallBack("IBMCLOUD_GT_API_ENDPOINT", globalTaggingEndpoint),
 		Authenticator: authenticator,
 	}
 	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
@@ -1870,17 +2289,29 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.globalTaggingServiceAPIV1.Service)
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureICDAPI configures the client returned by ICDAPI
+func (session *clientSession) configureICDAPI() {
+	sess := session.session
 
 	icdAPI, err := icdv4.New(sess.BluemixSession)
 	if err != nil {
 		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
 	}
 	session.icdServiceAPI = icdAPI
+}
+
+// configureCloudDatabasesV5 configures the client returned by CloudDatabasesV5
+func (session *clientSession) configureCloudDatabasesV5() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	var cloudDatabasesEndpoint string
 
@@ -1892,7 +2323,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Construct an "options" struct for creating the service client.
 	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
+		URL:           c.endpointFallBack("IBMCLOUD_DATABASES_API_ENDPOINT", cloudDatabasesEndpoint),
 		Authenticator: authenticator,
 	}
 
@@ -1900,7 +2331,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cloudDatabasesClient.Service)
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1908,50 +2339,90 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
 	}
+}
+
+// configureResourceCatalogAPI configures the client returned by ResourceCatalogAPI
+func (session *clientSession) configureResourceCatalogAPI() {
+	sess := session.session
 
 	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
 	}
 	session.resourceCatalogServiceAPI = resourceCatalogAPI
+}
+
+// configureResourceManagementAPIv2 configures the client returned by ResourceManagementAPIv2
+func (session *clientSession) configureResourceManagementAPIv2() {
+	sess := session.session
 
 	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
 	}
 	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+}
+
+// configureResourceControllerAPI configures the client returned by ResourceControllerAPI
+func (session *clientSession) configureResourceControllerAPI() {
+	sess := session.session
 
 	resourceControllerAPI, err := controller.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	session.resourceControllerServiceAPI = resourceControllerAPI
+}
+
+// configureResourceControllerAPIV2 configures the client returned by ResourceControllerAPIV2
+func (session *clientSession) configureResourceControllerAPIV2() {
+	sess := session.session
 
 	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
 	}
 	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+}
+
+// configureUserManagementAPI configures the client returned by UserManagementAPI
+func (session *clientSession) configureUserManagementAPI() {
+	sess := session.session
 
 	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
 	}
 	session.userManagementAPI = userManagementAPI
+}
+
+// configureCertificateManagerAPI configures the client returned by CertificateManagerAPI
+func (session *clientSession) configureCertificateManagerAPI() {
+	sess := session.session
 
 	certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
 	if err != nil {
 		session.certManagementErr = fmt.Errorf("[ERROR] Error occured while configuring Certificate manager service: %q", err)
 	}
 	session.certManagementAPI = certManagementAPI
+}
+
+// configureFunctionIAMNamespaceAPI configures the client returned by FunctionIAMNamespaceAPI
+func (session *clientSession) configureFunctionIAMNamespaceAPI() {
+	sess := session.session
 
 	namespaceFunction, err := functions.New(sess.BluemixSession)
 	if err != nil {
 		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
 	}
 	session.functionIAMNamespaceAPI = namespaceFunction
+}
+
+// configureAPIGateway configures the API GATEWAY service client
+func (session *clientSession) configureAPIGateway() {
+	c := session.config
+	fileMap := session.fileMap
 
-	//  API GATEWAY service
 	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
@@ -1960,32 +2431,48 @@ func (c *Config) ClientSession() (interface{}, error) {
 		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
 	}
 	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
+		URL:           c.endpointFallBack("IBMCLOUD_API_GATEWAY_ENDPOINT", apicurl),
 		Authenticator: &core.NoAuthAuthenticator{},
 	}
 	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
 	if err != nil {
 		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
+	} else {
+		apigatewayAPI.Service.SetHTTPClient(c.RetryPolicy().Client(apigatewayAPI.Service.Client))
 	}
 	session.apigatewayAPI = apigatewayAPI
+}
+
+// configureIBMPISession configures the POWER SYSTEMS Service client
+func (session *clientSession) configureIBMPISession() {
+	c := session.config
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
 
-	// POWER SYSTEMS Service
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
 		Authenticator: authenticator,
 		Debug:         os.Getenv("TF_LOG") != "",
 		Region:        c.Region,
-		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
+		URL:           c.endpointFallBack("IBMCLOUD_PI_API_ENDPOINT", piURL),
 		UserAccount:   userConfig.UserAccount,
 		Zone:          c.Zone,
 	}
+	// The Power client does not expose its HTTP client, so its requests are
+	// not retried with the retry policy
 	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
 	if err != nil {
 		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
 	}
 	session.ibmpiSession = ibmpisession
+}
+
+// configurePrivateDNSClientSession configures the PRIVATE DNS Service client
+func (session *clientSession) configurePrivateDNSClientSession() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// PRIVATE DNS Service
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
@@ -1994,7 +2481,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
 	}
 	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
+		URL:           c.endpointFallBack("IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", pdnsURL),
 		Authenticator: authenticator,
 	}
 	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
@@ -2002,13 +2489,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.pDNSClient.Service)
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureDirectlinkV1API configures the DIRECT LINK Service client
+func (session *clientSession) configureDirectlinkV1API() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// DIRECT LINK Service
 	ver := time.Now().Format("2006-01-02")
 	dlURL := dl.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2018,7 +2511,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
 	}
 	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
+		URL:           c.endpointFallBack("IBMCLOUD_DL_API_ENDPOINT", dlURL),
 		Authenticator: authenticator,
 		Version:       &ver,
 	}
@@ -2027,13 +2520,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.directlinkAPI.Service)
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureDirectlinkProviderV2API configures the DIRECT LINK PROVIDER Service client
+func (session *clientSession) configureDirectlinkProviderV2API() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	ver := time.Now().Format("2006-01-02")
 
-	// DIRECT LINK PROVIDER Service
 	dlproviderURL := dlProviderV2.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
@@ -2042,7 +2542,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
 	}
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
+		URL:           c.endpointFallBack("IBMCLOUD_DL_PROVIDER_API_ENDPOINT", dlproviderURL),
 		Authenticator: authenticator,
 		Version:       &ver,
 	}
@@ -2051,13 +2551,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.dlProviderAPI.Service)
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureTransitGatewayV1API configures the TRANSIT GATEWAY Service client
+func (session *clientSession) configureTransitGatewayV1API() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// TRANSIT GATEWAY Service
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
@@ -2066,7 +2572,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
 	}
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
+		URL:           c.endpointFallBack("IBMCLOUD_TG_API_ENDPOINT", tgURL),
 		Authenticator: authenticator,
 		Version:       CreateVersionDate(),
 	}
@@ -2075,48 +2581,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.transitgatewayAPI.Service)
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
 	}
+}
 
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+// configureCisZonesV1ClientSession configures the IBM Network CIS Zones service client
+func (session *clientSession) configureCisZonesV1ClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
-
-	// IBM Network CIS Zones service
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2129,13 +2606,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisZonesV1Client.Service)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisDNSRecordClientSession configures the IBM Network CIS DNS Record service client
+func (session *clientSession) configureCisDNSRecordClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS DNS Record service
 	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2147,13 +2630,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisDNSRecordsClient.Service)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisDNSRecordBulkClientSession configures the IBM Network CIS DNS Record bulk service client
+func (session *clientSession) configureCisDNSRecordBulkClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS DNS Record bulk service
 	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2167,13 +2656,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisDNSRecordBulkClient.Service)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisGLBPoolClientSession configures the IBM Network CIS Global load balancer pool client
+func (session *clientSession) configureCisGLBPoolClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Global load balancer pool
 	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2187,13 +2682,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisGLBPoolClient.Service)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisGLBClientSession configures the IBM Network CIS Global load balancer client
+func (session *clientSession) configureCisGLBClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Global load balancer
 	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
 		URL:            cisEndPoint,
 		Authenticator:  authenticator,
@@ -2207,13 +2708,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisGLBClient.Service)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisGLBHealthCheckClientSession configures the IBM Network CIS Global load balancer health check/monitor client
+func (session *clientSession) configureCisGLBHealthCheckClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Global load balancer health check/monitor
 	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2227,13 +2734,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisGLBHealthCheckClient.Service)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisIPClientSession configures the IBM Network CIS IP client
+func (session *clientSession) configureCisIPClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS IP
 	cisIPOpt := &cisipv1.CisIpApiV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2244,13 +2757,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisIPClient.Service)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisRLClientSession configures the IBM Network CIS Zone Rate Limit client
+func (session *clientSession) configureCisRLClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Zone Rate Limit
 	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2264,12 +2783,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisRLClient.Service)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// IBM Network CIS Alerts
+}
+
+// configureCisAlertsSession configures the IBM Network CIS Alerts client
+func (session *clientSession) configureCisAlertsSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
+
 	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2282,13 +2808,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisAlertsClient.Service)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisPageRuleClientSession configures the IBM Network CIS Page Rules client
+func (session *clientSession) configureCisPageRuleClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Page Rules
 	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2302,13 +2834,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisPageRuleClient.Service)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisEdgeFunctionClientSession configures the IBM Network CIS Edge Function client
+func (session *clientSession) configureCisEdgeFunctionClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Edge Function
 	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2323,13 +2861,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisEdgeFunctionClient.Service)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisSSLClientSession configures the IBM Network CIS SSL certificate client
+func (session *clientSession) configureCisSSLClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS SSL certificate
 	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2344,13 +2888,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisSSLClient.Service)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisWAFPackageClientSession configures the IBM Network CIS WAF Package client
+func (session *clientSession) configureCisWAFPackageClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS WAF Package
 	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2365,13 +2915,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisWAFPackageClient.Service)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisDomainSettingsClientSession configures the IBM Network CIS Domain settings client
+func (session *clientSession) configureCisDomainSettingsClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Domain settings
 	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2386,13 +2942,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisDomainSettingsClient.Service)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisRoutingClientSession configures the IBM Network CIS Routing client
+func (session *clientSession) configureCisRoutingClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Routing
 	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2407,13 +2969,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisRoutingClient.Service)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisWAFGroupClientSession configures the IBM Network CIS WAF Group client
+func (session *clientSession) configureCisWAFGroupClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS WAF Group
 	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2428,13 +2996,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisWAFGroupClient.Service)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisCacheClientSession configures the IBM Network CIS Cache service client
+func (session *clientSession) configureCisCacheClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Cache service
 	cisCacheOpt := &ciscachev1.CachingApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2449,13 +3023,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisCacheClient.Service)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisCustomPageClientSession configures the IBM Network CIS Custom pages service client
+func (session *clientSession) configureCisCustomPageClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Custom pages service
 	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2471,13 +3051,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisCustomPageClient.Service)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisAccessRuleClientSession configures the IBM Network CIS Firewall Access rule client
+func (session *clientSession) configureCisAccessRuleClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Firewall Access rule
 	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2492,13 +3078,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisAccessRuleClient.Service)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisUARuleClientSession configures the IBM Network CIS Firewall User Agent Blocking rule client
+func (session *clientSession) configureCisUARuleClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Firewall User Agent Blocking rule
 	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2513,13 +3105,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisUARuleClient.Service)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisLockdownClientSession configures the IBM Network CIS Firewall Lockdown rule client
+func (session *clientSession) configureCisLockdownClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Firewall Lockdown rule
 	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2534,13 +3132,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisLockdownClient.Service)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisRangeAppClientSession configures the IBM Network CIS Range Application rule client
+func (session *clientSession) configureCisRangeAppClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Range Application rule
 	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2555,13 +3159,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisRangeAppClient.Service)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisWAFRuleClientSession configures the IBM Network CIS WAF Rule Service client
+func (session *clientSession) configureCisWAFRuleClientSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS WAF Rule Service
 	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2576,13 +3186,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisWAFRuleClient.Service)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisLogpushJobsSession configures the IBM Network CIS LogpushJobs client
+func (session *clientSession) configureCisLogpushJobsSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS LogpushJobs
 	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2597,13 +3213,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisLogpushJobsClient.Service)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisMtlsSession configures the IBM MTLS Session client
+func (session *clientSession) configureCisMtlsSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM MTLS Session
 	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2616,13 +3238,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisMtlsErr)
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisMtlsClient.Service)
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisWebhookSession configures the IBM Network CIS Webhooks client
+func (session *clientSession) configureCisWebhookSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Webhooks
 	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2635,12 +3263,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisWebhooksClient.Service)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// IBM Network CIS Filters
+}
+
+// configureCisFiltersSession configures the IBM Network CIS Filters client
+func (session *clientSession) configureCisFiltersSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
+
 	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2652,13 +3287,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisFiltersClient.Service)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisFirewallRulesSession configures the IBM Network CIS Firewall rules client
+func (session *clientSession) configureCisFirewallRulesSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Firewall rules
 	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2670,13 +3311,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisFirewallRulesClient.Service)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCisOrigAuthSession configures the IBM Network CIS Authenticated Origin Pull client
+func (session *clientSession) configureCisOrigAuthSession() {
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 
-	// IBM Network CIS Authenticated Origin Pull
 	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
 		URL:            cisEndPoint,
 		Authenticator:  authenticator,
@@ -2692,13 +3339,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisOriginAuthPullErr)
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cisOriginAuthClient.Service)
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureIAMIdentityV1API configures the IAM IDENTITY Service client
+func (session *clientSession) configureIAMIdentityV1API() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// IAM IDENTITY Service
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
 	iamIdenityURL := iamidentity.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2713,21 +3366,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		URL:           c.endpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamIdenityURL),
 	}
 	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
 	if err != nil {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(iamIdentityClient.Service)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
+
+// configureIAMPolicyManagementV1API configures the IAM POLICY MANAGEMENT Service client
+func (session *clientSession) configureIAMPolicyManagementV1API() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// IAM POLICY MANAGEMENT Service
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2741,21 +3400,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		URL:           c.endpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamPolicyManagementURL),
 	}
 	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
 	if err != nil {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(iamPolicyManagementClient.Service)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
+
+// configureIAMAccessGroupsV2 configures the IAM ACCESS GROUP client
+func (session *clientSession) configureIAMAccessGroupsV2() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// IAM ACCESS GROUP
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2769,21 +3434,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		URL:           c.endpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamAccessGroupsURL),
 	}
 	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
 	if err != nil {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(iamAccessGroupsClient.Service)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
+}
+
+// configureResourceManagerV2API configures the RESOURCE MANAGEMENT Service client
+func (session *clientSession) configureResourceManagerV2API() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// RESOURCE MANAGEMENT Service
 	rmURL := resourcemanager.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2805,41 +3476,54 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		URL:           c.endpointFallBack("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", rmURL),
 	}
 	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
 	if err != nil {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(resourceManagerClient.Service)
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
+
+// configureIBMCloudShellV1 configures the CLOUD SHELL Service client
+func (session *clientSession) configureIBMCloudShellV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
-	//CLOUD SHELL Service
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
 	}
 	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
+		URL:           c.endpointFallBack("IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", cloudShellUrl),
 	}
 	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
 	if err != nil {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.ibmCloudShellClient.Service)
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureEnterpriseManagementV1 configures the ENTERPRISE Service client
+func (session *clientSession) configureEnterpriseManagementV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// ENTERPRISE Service
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
@@ -2862,21 +3546,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		URL:           c.endpointFallBack("IBMCLOUD_ENTERPRISE_API_ENDPOINT", enterpriseURL),
 	}
 	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
 	if err != nil {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(enterpriseManagementClient.Service)
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
+
+// configureResourceControllerV2API configures the RESOURCE CONTROLLER Service client
+func (session *clientSession) configureResourceControllerV2API() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 
-	// RESOURCE CONTROLLER Service
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2898,21 +3588,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
+		URL:           c.endpointFallBack("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", rcURL),
 	}
 	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
 	if err != nil {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(resourceControllerClient.Service)
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
+
+// configureSecretsManagerV1 configures the SECRETS MANAGER Service client
+func (session *clientSession) configureSecretsManagerV1() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
-	// SECRETS MANAGER Service
 	secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
 		Authenticator: authenticator,
 	}
@@ -2923,14 +3619,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureSatelliteClientSession configures the SATELLITE Service client
+func (session *clientSession) configureSatelliteClientSession() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
-	// SATELLITE Service
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
@@ -2939,7 +3642,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
 	}
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
+		URL:           c.endpointFallBack("IBMCLOUD_SATELLITE_API_ENDPOINT", containerEndpoint),
 		Authenticator: authenticator,
 	}
 	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
@@ -2949,13 +3652,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.satelliteClient.Service)
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureSatellitLinkClientSession configures the SATELLITE LINK Service client
+func (session *clientSession) configureSatellitLinkClientSession() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
-	// SATELLITE LINK Service
 	// Construct an "options" struct for creating the service client.
 	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2965,7 +3675,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
 	}
 	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
+		URL:           c.endpointFallBack("IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", satelliteLinkEndpoint),
 		Authenticator: authenticator,
 	}
 	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
@@ -2974,12 +3684,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.satelliteLinkClient.Service)
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureESschemaRegistrySession configures the client returned by ESschemaRegistrySession
+func (session *clientSession) configureESschemaRegistrySession() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
 	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
 		Authenticator: authenticator,
@@ -2989,13 +3706,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.esSchemaRegistryClient.Service)
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureConfigurationGovernanceV1 configures the Governance Service client
+func (session *clientSession) configureConfigurationGovernanceV1() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 
-	// Governance Service
 	var configServiceApiClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		configServiceApiClientURL, err = configurationgovernancev1.GetServiceURLForRegion("private." + c.Region)
@@ -3010,12 +3733,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	configServiceApiClientOptions := &configurationgovernancev1.ConfigurationGovernanceV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT"}, configServiceApiClientURL),
+		URL:           c.endpointFallBack("IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT", configServiceApiClientURL),
 	}
 	session.configServiceApiClient, err = configurationgovernancev1.NewConfigurationGovernanceV1(configServiceApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.configServiceApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.configServiceApiClient.Service)
 		// Add custom header for analytics
 		session.configServiceApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3023,8 +3746,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.configServiceApiClientErr = fmt.Errorf("Error occurred while configuring Config Service API service: %q", err)
 	}
+}
+
+// configurePostureManagementV1 configures the COMPLIANCE Service client
+func (session *clientSession) configurePostureManagementV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
+	var err error
 
-	//COMPLIANCE Service
 	// Construct an "options" struct for creating the service client.
 	var postureManagementClientURL string
 	if c.Visibility == "public" || c.Visibility == "public-and-private" {
@@ -3040,7 +3771,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	postureManagementClientOptions := &posturemanagementv1.PostureManagementV1Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURL),
+		URL:           c.endpointFallBack("IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURL),
 		AccountID:     core.StringPtr(userConfig.UserAccount),
 	}
 
@@ -3051,14 +3782,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClient != nil && session.postureManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.postureManagementClient.Service)
 		// Add custom header for analytics
 		session.postureManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configurePostureManagementV2 configures the COMPLIANCE Service v2 version client
+func (session *clientSession) configurePostureManagementV2() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
-	//COMPLIANCE Service v2 version
 	// Construct an "options" struct for creating the service client.
 	var postureManagementClientURLv2 string
 	if c.Visibility == "public" || c.Visibility == "public-and-private" {
@@ -3074,7 +3812,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	postureManagementClientOptionsv2 := &posturemanagementv2.PostureManagementV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COMPLIANCE_API_ENDPOINT"}, postureManagementClientURLv2),
+		URL:           c.endpointFallBack("IBMCLOUD_COMPLIANCE_API_ENDPOINT", postureManagementClientURLv2),
 	}
 
 	// Construct the service client.
@@ -3084,12 +3822,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.postureManagementClientv2 != nil && session.postureManagementClientv2.Service != nil {
 		// Enable retries for API calls
-		session.postureManagementClientv2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.postureManagementClientv2.Service)
 		// Add custom header for analytics
 		session.postureManagementClientv2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+// configureCdToolchainV2 configures the client returned by CdToolchainV2
+func (session *clientSession) configureCdToolchainV2() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating the service client.
 	var cdToolchainClientURL string
@@ -3109,14 +3855,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
+		URL:           c.endpointFallBack("IBMCLOUD_TOOLCHAIN_ENDPOINT", cdToolchainClientURL),
 	}
 
 	// Construct the service client.
 	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cdToolchainClient.Service)
 		// Add custom header for analytics
 		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3124,6 +3870,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
+}
+
+// configureCdTektonPipelineV2 configures the client returned by CdTektonPipelineV2
+func (session *clientSession) configureCdTektonPipelineV2() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 
 	// Construct an "options" struct for creating the tekton pipeline service client.
 	var cdTektonPipelineClientURL string
@@ -3143,13 +3897,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
 		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
+		URL:           c.endpointFallBack("IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", cdTektonPipelineClientURL),
 	}
 	// Construct the service client.
 	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.RetryPolicy().EnableRetries(session.cdTektonPipelineClient.Service)
 		// Add custom header for analytics
 		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3157,13 +3911,6 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
 	}
-
-	if os.Getenv("TF_LOG") != "" {
-		logDestination := log.Writer()
-		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
-	}
-	return session, nil
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -3176,13 +3923,13 @@ func newSession(c *Config) (*Session, error) {
 	ibmSession := &Session{}
 
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
-		Retries:   c.RetryCount,
-		RetryWait: c.RetryDelay,
+		Endpoint: c.SoftLayerEndpointURL,
+		Timeout:  c.SoftLayerTimeout,
+		UserName: c.SoftLayerUserName,
+		APIKey:   c.SoftLayerAPIKey,
+		Debug:    os.Getenv("TF_LOG") != "",
+		// Failed requests are retried by the HTTP client with the retry policy
+		HTTPClient: c.RetryPolicy().Client(&gohttp.Client{}),
 	}
 
 	if c.IAMToken != "" {
@@ -3205,6 +3952,10 @@ func newSession(c *Config) (*Session, error) {
 		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
 	}
 
+	// Failed requests are retried by the HTTP client of the Bluemix session
+	// with the retry policy, instead of by the Bluemix client
+	noRetries := 0
+
 	if c.IAMToken != "" {
 		log.Println("Configuring IBM Cloud Session with token")
 		var sess *bxsession.Session
@@ -3212,16 +3963,17 @@ func newSession(c *Config) (*Session, error) {
 			IAMAccessToken:  c.IAMToken,
 			IAMRefreshToken: c.IAMRefreshToken,
 			//Comment out debug mode for v0.12
-			Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
-			EndpointsFile: c.EndpointsFile,
-			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			Debug:           os.Getenv("TF_LOG") != "",
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			MaxRetries:      &noRetries,
+			Visibility:      c.Visibility,
+			EndpointsFile:   c.EndpointsFile,
+			EndpointLocator: newEndpointLocator(c),
+			UserAgent:       fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
+		bmxConfig.HTTPClient = c.RetryPolicy().Client(http.NewHTTPClient(bmxConfig))
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
@@ -3235,16 +3987,17 @@ func newSession(c *Config) (*Session, error) {
 		bmxConfig := &bluemix.Config{
 			BluemixAPIKey: c.BluemixAPIKey,
 			//Comment out debug mode for v0.12
-			Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
-			EndpointsFile: c.EndpointsFile,
-			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			Debug:           os.Getenv("TF_LOG") != "",
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			MaxRetries:      &noRetries,
+			Visibility:      c.Visibility,
+			EndpointsFile:   c.EndpointsFile,
+			EndpointLocator: newEndpointLocator(c),
+			UserAgent:       fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
+		bmxConfig.HTTPClient = c.RetryPolicy().Client(http.NewHTTPClient(bmxConfig))
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
@@ -3283,7 +4036,7 @@ func authenticateCF(sess *bxsession.Session) error {
 	return tokenRefresher.AuthenticateAPIKey(config.BluemixAPIKey)
 }
 
-func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Duration) (*UserConfig, error) {
+func fetchUserDetails(sess *bxsession.Session, policy *RetryPolicy, attempt int) (*UserConfig, error) {
 	config := sess.Config
 	user := UserConfig{}
 	var bluemixToken string
@@ -3299,12 +4052,12 @@ func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Dura
 	})
 	//TODO validate with key
 	if err != nil && !strings.Contains(err.Error(), "key is of invalid type") {
-		if retries > 0 {
+		if attempt < policy.MaxRetries {
 			if config.BluemixAPIKey != "" {
-				time.Sleep(retryDelay)
-				log.Printf("Retrying authentication for user details %d", retries)
+				time.Sleep(policy.Backoff(attempt, nil))
+				log.Printf("Retrying authentication for user details %d", attempt+1)
 				_ = authenticateAPIKey(sess)
-				return fetchUserDetails(sess, retries-1, retryDelay)
+				return fetchUserDetails(sess, policy, attempt+1)
 			}
 		}
 		return &user, err
@@ -3361,6 +4114,14 @@ func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defau
 	return defaultValue
 }
 
+// keyProtectTransport returns the transport of the Key Protect clients, which
+// retries with the retry policy. The Key Protect client has its own retries,
+// which are turned off.
+func (c *Config) keyProtectTransport() gohttp.RoundTripper {
+	kp.RetryMax = 0
+	return c.RetryPolicy().Transport(DefaultTransport())
+}
+
 // DefaultTransport ...
 func DefaultTransport() gohttp.RoundTripper {
 	transport := &gohttp.Transport{
@@ -3374,27 +4135,9 @@ func DefaultTransport() gohttp.RoundTripper {
 	return transport
 }
 
+// isRetryable reports whether an error is retryable with the default retry policy
 func isRetryable(err error) bool {
-	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
-			return true
-		}
-	}
-
-	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(*net.OpError); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(net.UnknownNetworkError); ok && netErr.Timeout() {
-		return true
-	}
-
-	return false
+	return (&Config{}).RetryPolicy().IsRetryable(err)
 }
 
 func ContructEndpoint(subdomain, domain string) string {
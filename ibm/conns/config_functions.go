@@ -27,7 +27,12 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 		return nil, err
 	}
 
-	functionsClient, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	// The transport of the session retries failed requests
+	httpClient := http.DefaultClient
+	if c.HTTPClient != nil {
+		httpClient = &http.Client{Transport: c.HTTPClient.Transport}
+	}
+	functionsClient, err := whisk.NewClient(httpClient, &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
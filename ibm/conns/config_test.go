@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func testClientSession() *clientSession {
+	return &clientSession{
+		session: &Session{},
+		config: &Config{
+			Region:     "us-south",
+			Visibility: "public",
+		},
+		authenticator: &core.NoAuthAuthenticator{},
+	}
+}
+
+func TestClientSessionLazyConfiguration(t *testing.T) {
+	session := testClientSession()
+	if session.vpcAPI != nil {
+		t.Fatal("VPC client was configured before it was requested")
+	}
+
+	clients := make([]*vpcv1.VpcV1, 20)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := session.VpcV1API()
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			clients[i] = client
+		}(i)
+	}
+	wg.Wait()
+
+	for _, client := range clients {
+		if client == nil || client != clients[0] {
+			t.Fatalf("expected every caller to get the same cached client, got %p and %p", client, clients[0])
+		}
+	}
+	if ua := clients[0].Service.DefaultHeaders.Get("X-Original-User-Agent"); ua != "terraform-provider-ibm/"+version.Version {
+		t.Fatalf("bad user agent header: %q", ua)
+	}
+	if session.pushServiceClient != nil {
+		t.Fatal("unrelated clients must not be configured")
+	}
+}
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	session := testClientSession()
+	session.bluemixSessionErr = errEmptyBluemixCredentials
+	session.vpcErr = errEmptyBluemixCredentials
+
+	client, err := session.VpcV1API()
+	if err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %q, got %v", errEmptyBluemixCredentials, err)
+	}
+	if client != nil {
+		t.Fatal("no client should be configured without credentials")
+	}
+}
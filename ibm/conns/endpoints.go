@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+)
+
+// The endpoint of a service is resolved with the following precedence, highest first:
+//
+//  1. the attribute of the endpoints block of the provider configuration, e.g. vpc
+//  2. the environment variable of the endpoint, e.g. IBMCLOUD_IS_NG_API_ENDPOINT
+//  3. the endpoints file set with endpoints_file_path or IBMCLOUD_ENDPOINTS_FILE_PATH,
+//     unless the visibility is public-and-private
+//  4. the default endpoint of the service for the region and visibility
+//
+// The endpoints file and the default are applied by each configure function,
+// the endpoints block and the environment by endpointFallBack.
+
+// EndpointEnvs maps the attributes of the provider endpoints block to the
+// environment variable that overrides the same endpoint
+var EndpointEnvs = map[string]string{
+	"account_management":         "IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT",
+	"api_gateway":                "IBMCLOUD_API_GATEWAY_ENDPOINT",
+	"app_configuration":          "IBMCLOUD_APP_CONFIG_API_ENDPOINT",
+	"appid":                      "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"atracker":                   "IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"catalog_management":         "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"certificate_manager":        "IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT",
+	"cf":                         "IBMCLOUD_CF_API_ENDPOINT",
+	"cis":                        "IBMCLOUD_CIS_API_ENDPOINT",
+	"cloud_shell":                "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"cloudant":                   "IBMCLOUD_CLOUDANT_API_ENDPOINT",
+	"compliance":                 "IBMCLOUD_COMPLIANCE_API_ENDPOINT",
+	"configuration_governance":   "IBMCLOUD_CONFIGURATION_GOVERNANCE_API_ENDPOINT",
+	"container":                  "IBMCLOUD_CS_API_ENDPOINT",
+	"container_registry":         "IBMCLOUD_CR_API_ENDPOINT",
+	"context_based_restrictions": "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"cos":                        "IBMCLOUD_COS_ENDPOINT",
+	"cos_config":                 "IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"cse":                        "IBMCLOUD_CSE_ENDPOINT",
+	"databases":                  "IBMCLOUD_DATABASES_API_ENDPOINT",
+	"directlink":                 "IBMCLOUD_DL_API_ENDPOINT",
+	"directlink_provider":        "IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"enterprise":                 "IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"event_notifications":        "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"functions":                  "IBMCLOUD_FUNCTIONS_API_ENDPOINT",
+	"global_search":              "IBMCLOUD_GS_API_ENDPOINT",
+	"global_tagging":             "IBMCLOUD_GT_API_ENDPOINT",
+	"hpcs":                       "IBMCLOUD_HPCS_API_ENDPOINT",
+	"iam":                        "IBMCLOUD_IAM_API_ENDPOINT",
+	"iam_pap":                    "IBMCLOUD_IAMPAP_API_ENDPOINT",
+	"icd":                        "IBMCLOUD_ICD_API_ENDPOINT",
+	"key_protect":                "IBMCLOUD_KP_API_ENDPOINT",
+	"mccp":                       "IBMCLOUD_MCCP_API_ENDPOINT",
+	"power":                      "IBMCLOUD_PI_API_ENDPOINT",
+	"private_dns":                "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"push_notifications":         "IBMCLOUD_PUSH_API_ENDPOINT",
+	"resource_catalog":           "IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"resource_controller":        "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"resource_manager":           "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"satellite":                  "IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"satellite_link":             "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"scc_admin":                  "IBMCLOUD_SCC_ADMIN_API_ENDPOINT",
+	"schematics":                 "IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"secrets_manager":            "IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT",
+	"tekton_pipeline":            "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"toolchain":                  "IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"transit_gateway":            "IBMCLOUD_TG_API_ENDPOINT",
+	"uaa":                        "IBMCLOUD_UAA_ENDPOINT",
+	"user_management":            "IBMCLOUD_USER_MANAGEMENT_ENDPOINT",
+	"vpc":                        "IBMCLOUD_IS_NG_API_ENDPOINT",
+}
+
+// endpointEnvAliases maps the environment variables that override the same
+// endpoint as another variable of EndpointEnvs to that variable
+var endpointEnvAliases = map[string]string{
+	"IBMCLOUD_CLOUDANT_ENDPOINT": "IBMCLOUD_CLOUDANT_API_ENDPOINT",
+}
+
+// ValidateEndpoints checks that every attribute of the endpoints block is known
+// and holds an absolute http or https URL
+func ValidateEndpoints(endpoints map[string]string) error {
+	keys := make([]string, 0, len(endpoints))
+	for k := range endpoints {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if _, ok := EndpointEnvs[k]; !ok {
+			return fmt.Errorf("[ERROR] Unknown endpoint %q in the endpoints block", k)
+		}
+		v := endpoints[k]
+		if v == "" {
+			continue
+		}
+		u, err := url.Parse(v)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Invalid %s endpoint %q: %s", k, v, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("[ERROR] Invalid %s endpoint %q: expected an absolute http or https URL", k, v)
+		}
+	}
+	return nil
+}
+
+// endpoint returns the URL set in the endpoints block for the endpoint
+// overridden by the environment variable env, or an empty string
+func (c *Config) endpoint(env string) string {
+	if alias, ok := endpointEnvAliases[env]; ok {
+		env = alias
+	}
+	for k, v := range c.Endpoints {
+		if v != "" && EndpointEnvs[k] == env {
+			return v
+		}
+	}
+	return ""
+}
+
+// endpointFallBack returns the URL of the endpoint overridden by the
+// environment variable env from the endpoints block or the environment,
+// and defaultValue otherwise
+func (c *Config) endpointFallBack(env, defaultValue string) string {
+	if v := c.endpoint(env); v != "" {
+		return v
+	}
+	return EnvFallBack([]string{env}, defaultValue)
+}
+
+// EndpointFallBack resolves the endpoint overridden by the environment
+// variable env for the service clients that are not configured by the session,
+// such as the clients built for a service instance
+func (sess *clientSession) EndpointFallBack(env, defaultValue string) string {
+	return sess.config.endpointFallBack(env, defaultValue)
+}
+
+// endpointLocator gives the endpoints block precedence over the Bluemix
+// endpoint locator, which resolves the environment, the endpoints file and
+// the defaults
+type endpointLocator struct {
+	endpoints.EndpointLocator
+	config *Config
+}
+
+func newEndpointLocator(c *Config) endpoints.EndpointLocator {
+	return &endpointLocator{
+		EndpointLocator: endpoints.NewEndpointLocator(c.Region, c.Visibility, c.EndpointsFile),
+		config:          c,
+	}
+}
+
+func (l *endpointLocator) fallBack(env string, locate func() (string, error)) (string, error) {
+	if v := l.config.endpoint(env); v != "" {
+		return v, nil
+	}
+	return locate()
+}
+
+func (l *endpointLocator) AccountManagementEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (l *endpointLocator) CertificateManagerEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT", l.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (l *endpointLocator) CFAPIEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_CF_API_ENDPOINT", l.EndpointLocator.CFAPIEndpoint)
+}
+
+func (l *endpointLocator) ContainerEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_CS_API_ENDPOINT", l.EndpointLocator.ContainerEndpoint)
+}
+
+func (l *endpointLocator) ContainerRegistryEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_CR_API_ENDPOINT", l.EndpointLocator.ContainerRegistryEndpoint)
+}
+
+func (l *endpointLocator) CisEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_CIS_API_ENDPOINT", l.EndpointLocator.CisEndpoint)
+}
+
+func (l *endpointLocator) GlobalSearchEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_GS_API_ENDPOINT", l.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (l *endpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_GT_API_ENDPOINT", l.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (l *endpointLocator) IAMEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_IAM_API_ENDPOINT", l.EndpointLocator.IAMEndpoint)
+}
+
+func (l *endpointLocator) IAMPAPEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_IAMPAP_API_ENDPOINT", l.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (l *endpointLocator) ICDEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_ICD_API_ENDPOINT", l.EndpointLocator.ICDEndpoint)
+}
+
+func (l *endpointLocator) MCCPAPIEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_MCCP_API_ENDPOINT", l.EndpointLocator.MCCPAPIEndpoint)
+}
+
+func (l *endpointLocator) ResourceManagementEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (l *endpointLocator) ResourceControllerEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", l.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (l *endpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", l.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (l *endpointLocator) UAAEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_UAA_ENDPOINT", l.EndpointLocator.UAAEndpoint)
+}
+
+func (l *endpointLocator) CseEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_CSE_ENDPOINT", l.EndpointLocator.CseEndpoint)
+}
+
+func (l *endpointLocator) SchematicsEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_SCHEMATICS_API_ENDPOINT", l.EndpointLocator.SchematicsEndpoint)
+}
+
+func (l *endpointLocator) UserManagementEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_USER_MANAGEMENT_ENDPOINT", l.EndpointLocator.UserManagementEndpoint)
+}
+
+func (l *endpointLocator) HpcsEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_HPCS_API_ENDPOINT", l.EndpointLocator.HpcsEndpoint)
+}
+
+func (l *endpointLocator) FunctionsEndpoint() (string, error) {
+	return l.fallBack("IBMCLOUD_FUNCTIONS_API_ENDPOINT", l.EndpointLocator.FunctionsEndpoint)
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"testing"
+)
+
+func TestEndpointPrecedence(t *testing.T) {
+	const env = "IBMCLOUD_IS_NG_API_ENDPOINT"
+	old, ok := os.LookupEnv(env)
+	defer func() {
+		if ok {
+			os.Setenv(env, old)
+		} else {
+			os.Unsetenv(env)
+		}
+	}()
+
+	os.Unsetenv(env)
+	session := testClientSession()
+	vpc, _ := session.VpcV1API()
+	if url := vpc.Service.Options.URL; url != "https://us-south.iaas.cloud.ibm.com/v1" {
+		t.Fatalf("expected the default endpoint, got %s", url)
+	}
+
+	os.Setenv(env, "https://env.example.com/v1")
+	session = testClientSession()
+	vpc, _ = session.VpcV1API()
+	if url := vpc.Service.Options.URL; url != "https://env.example.com/v1" {
+		t.Fatalf("expected the environment endpoint, got %s", url)
+	}
+
+	session = testClientSession()
+	session.config.Endpoints = map[string]string{"vpc": "https://block.example.com/v1"}
+	vpc, _ = session.VpcV1API()
+	if url := vpc.Service.Options.URL; url != "https://block.example.com/v1" {
+		t.Fatalf("expected the endpoints block to take precedence, got %s", url)
+	}
+
+	locator := newEndpointLocator(&Config{
+		Region:    "us-south",
+		Endpoints: map[string]string{"container": "https://cs.example.com"},
+	})
+	if url, _ := locator.ContainerEndpoint(); url != "https://cs.example.com" {
+		t.Fatalf("expected the Bluemix locator to use the endpoints block, got %s", url)
+	}
+}
+
+func TestEndpointFallBack(t *testing.T) {
+	for _, env := range []string{"IBMCLOUD_COS_ENDPOINT", "IBMCLOUD_CLOUDANT_ENDPOINT"} {
+		old, ok := os.LookupEnv(env)
+		defer func(env string) {
+			if ok {
+				os.Setenv(env, old)
+			} else {
+				os.Unsetenv(env)
+			}
+		}(env)
+		os.Unsetenv(env)
+	}
+
+	session := testClientSession()
+	if url := session.EndpointFallBack("IBMCLOUD_COS_ENDPOINT", "s3.us-south.cloud-object-storage.appdomain.cloud"); url != "s3.us-south.cloud-object-storage.appdomain.cloud" {
+		t.Fatalf("expected the default endpoint, got %s", url)
+	}
+
+	session.config.Endpoints = map[string]string{
+		"cos":      "https://s3.example.com",
+		"cloudant": "https://cloudant.example.com",
+	}
+	if url := session.EndpointFallBack("IBMCLOUD_COS_ENDPOINT", "s3.us-south.cloud-object-storage.appdomain.cloud"); url != "https://s3.example.com" {
+		t.Fatalf("expected the endpoints block to take precedence, got %s", url)
+	}
+	if url := session.EndpointFallBack("IBMCLOUD_CLOUDANT_ENDPOINT", ""); url != "https://cloudant.example.com" {
+		t.Fatalf("expected the cloudant endpoint to override IBMCLOUD_CLOUDANT_ENDPOINT, got %s", url)
+	}
+}
+
+func TestValidateEndpoints(t *testing.T) {
+	valid := []map[string]string{
+		nil,
+		{"vpc": "https://us-south.iaas.cloud.ibm.com/v1"},
+		{"iam": "http://127.0.0.1:8080", "cos_config": ""},
+	}
+	for _, endpoints := range valid {
+		if err := ValidateEndpoints(endpoints); err != nil {
+			t.Errorf("unexpected error for %v: %s", endpoints, err)
+		}
+	}
+
+	invalid := []map[string]string{
+		{"not_a_service": "https://example.com"},
+		{"vpc": "us-south.iaas.cloud.ibm.com"},
+		{"vpc": "ftp://example.com"},
+		{"vpc": "https://"},
+	}
+	for _, endpoints := range invalid {
+		if err := ValidateEndpoints(endpoints); err == nil {
+			t.Errorf("expected an error for %v", endpoints)
+		}
+	}
+}
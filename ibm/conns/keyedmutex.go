@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// KeyedMutex serializes changes across resources that share knowledge of the
+// keys they must serialize on, e.g. the load balancer ID of its listeners,
+// pools and members.
+//
+// Several keys are always acquired in the same, sorted order, so two resources
+// that lock the same keys cannot deadlock. Waiting for a key is bounded by the
+// context, usually the timeout of the resource operation, and the key is
+// removed once no resource holds or waits for it.
+type KeyedMutex struct {
+	lock  sync.Mutex
+	store map[string]*keyedLock
+
+	// waitLogInterval is how often a resource waiting for a key logs it
+	waitLogInterval time.Duration
+}
+
+type keyedLock struct {
+	// held has a value while the key is locked
+	held chan struct{}
+
+	// refs is the number of resources holding or waiting for the key
+	refs int
+}
+
+// IbmKeyedMutex is the global KeyedMutex for use within this plugin.
+var IbmKeyedMutex = NewKeyedMutex()
+
+// NewKeyedMutex returns a properly initialized KeyedMutex
+func NewKeyedMutex() *KeyedMutex {
+	return &KeyedMutex{
+		store:           make(map[string]*keyedLock),
+		waitLogInterval: time.Minute,
+	}
+}
+
+// Lock locks the keys, waiting until they are all unlocked or the context is
+// done. It returns the function that unlocks them, which the caller is
+// responsible for calling, usually with defer.
+func (m *KeyedMutex) Lock(ctx context.Context, keys ...string) (unlock func(), err error) {
+	keys = canonicalKeys(keys)
+	for i, key := range keys {
+		if err := m.lockKey(ctx, key); err != nil {
+			m.unlockKeys(keys[:i])
+			return nil, err
+		}
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() { m.unlockKeys(keys) })
+	}, nil
+}
+
+// LockTimeout locks the keys like Lock, waiting at most timeout, e.g. the
+// d.Timeout(schema.TimeoutCreate) of a resource without a context.
+func (m *KeyedMutex) LockTimeout(timeout time.Duration, keys ...string) (unlock func(), err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	release, err := m.Lock(ctx, keys...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return func() {
+		release()
+		cancel()
+	}, nil
+}
+
+func (m *KeyedMutex) lockKey(ctx context.Context, key string) error {
+	l := m.ref(key)
+	start := time.Now()
+	log.Printf("[DEBUG] Locking %q", key)
+
+	ticker := time.NewTicker(m.waitLogInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case l.held <- struct{}{}:
+			log.Printf("[DEBUG] Locked %q after waiting %s", key, time.Since(start).Round(time.Millisecond))
+			return nil
+		case <-ticker.C:
+			log.Printf("[INFO] Still waiting for the lock on %q after %s", key, time.Since(start).Round(time.Second))
+		case <-ctx.Done():
+			m.unref(key)
+			return fmt.Errorf("[ERROR] Error waiting for the lock on %q, held by another resource, after %s: %s", key, time.Since(start).Round(time.Second), ctx.Err())
+		}
+	}
+}
+
+// unlockKeys unlocks the keys in the reverse order they were locked
+func (m *KeyedMutex) unlockKeys(keys []string) {
+	for i := len(keys) - 1; i >= 0; i-- {
+		m.lock.Lock()
+		l := m.store[keys[i]]
+		m.lock.Unlock()
+
+		log.Printf("[DEBUG] Unlocking %q", keys[i])
+		<-l.held
+		m.unref(keys[i])
+	}
+}
+
+// ref returns the lock of the key, adding it when no one holds or waits for it
+func (m *KeyedMutex) ref(key string) *keyedLock {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	l, ok := m.store[key]
+	if !ok {
+		l = &keyedLock{held: make(chan struct{}, 1)}
+		m.store[key] = l
+	}
+	l.refs++
+	return l
+}
+
+// unref removes the lock of the key once no one holds or waits for it
+func (m *KeyedMutex) unref(key string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	l := m.store[key]
+	l.refs--
+	if l.refs == 0 {
+		delete(m.store, key)
+	}
+}
+
+// canonicalKeys returns the keys sorted and without duplicates
+func canonicalKeys(keys []string) []string {
+	sorted := make([]string, len(keys))
+	copy(sorted, keys)
+	sort.Strings(sorted)
+	unique := sorted[:0]
+	for _, key := range sorted {
+		if len(unique) == 0 || key != unique[len(unique)-1] {
+			unique = append(unique, key)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestKeyedMutexLock(t *testing.T) {
+	m := NewKeyedMutex()
+
+	unlock, err := m.Lock(context.Background(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		unlock, err := m.Lock(context.Background(), "bar", "foo")
+		if err == nil {
+			unlock()
+		}
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	unlock()
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second lock blocked after unlock. This shouldn't happen.")
+	}
+	if len(m.store) != 0 {
+		t.Errorf("expected the idle keys to be removed, got %v", m.store)
+	}
+}
+
+func TestKeyedMutexContext(t *testing.T) {
+	m := NewKeyedMutex()
+
+	unlock, err := m.Lock(context.Background(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	if _, err := m.LockTimeout(10*time.Millisecond, "bar", "foo"); err == nil {
+		t.Fatal("expected an error once the timeout is reached")
+	}
+	if _, ok := m.store["bar"]; ok {
+		t.Error("expected the keys locked before the timeout to be unlocked")
+	}
+	if l := m.store["foo"]; l == nil || l.refs != 1 {
+		t.Errorf("expected only the holder of the key to be left, got %v", l)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := m.Lock(ctx, "foo"); err == nil {
+		t.Fatal("expected an error for a canceled context")
+	}
+}
+
+func TestKeyedMutexNoDeadlock(t *testing.T) {
+	m := NewKeyedMutex()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		keys := []string{"a", "b", "c"}
+		if i%2 == 1 {
+			keys = []string{"c", "b", "a", "a"}
+		}
+		wg.Add(1)
+		go func(keys []string) {
+			defer wg.Done()
+			unlock, err := m.LockTimeout(5*time.Second, keys...)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer unlock()
+		}(keys)
+	}
+	wg.Wait()
+	if len(m.store) != 0 {
+		t.Errorf("expected the idle keys to be removed, got %v", m.store)
+	}
+}
+
+func TestCanonicalKeys(t *testing.T) {
+	keys := []string{"b", "a", "b"}
+	if got := canonicalKeys(keys); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("expected the keys sorted without duplicates, got %v", got)
+	}
+	if !reflect.DeepEqual(keys, []string{"b", "a", "b"}) {
+		t.Errorf("expected the keys of the caller to be left unchanged, got %v", keys)
+	}
+}
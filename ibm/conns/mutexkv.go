@@ -12,15 +12,11 @@ import (
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 //
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
+// Deprecated: Use KeyedMutex, which waits within the timeout of the resource
+// and locks several keys without deadlocks.
 //
 // The initial use case is to let aws_security_group_rule resources serialize
 // their access to individual security groups based on SG ID.
-
-// This is a global MutexKV for use within this plugin.
-var IbmMutexKV = NewMutexKV()
-
 type MutexKV struct {
 	lock  sync.Mutex
 	store map[string]*sync.Mutex
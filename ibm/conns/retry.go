@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// DefaultRetryBaseDelay is the wait before the first retry of a failed request
+	DefaultRetryBaseDelay = 1 * time.Second
+	// DefaultRetryMaxDelay is the longest wait between two attempts, unless the
+	// API asks for a longer one with a Retry-After header
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the HTTP status codes retried when the
+// provider does not set retryable_status_codes
+var DefaultRetryableStatusCodes = []int{408, 429, 500, 502, 503, 504, 520, 599}
+
+// RetryPolicy decides which failed API requests are retried and how long to
+// wait between attempts. The same policy is used by every client built by
+// ClientSession, except the Power client, whose go-openapi runtime does not
+// let its transport be replaced through the ibmpisession options.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a failed request is retried
+	MaxRetries int
+
+	// The wait starts at BaseDelay and doubles with every attempt up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// Jitter picks each wait at random between half and all of its value, so
+	// that requests which failed together are not retried together
+	Jitter bool
+
+	// RetryableStatusCodes are the HTTP status codes that are retried.
+	// Connection errors and timeouts are always retried. Only idempotent
+	// requests are retried this way; a POST or PATCH is retried only when
+	// the API answers 429 or 503 with a Retry-After header, since the first
+	// attempt may have been carried out by the API before it failed.
+	RetryableStatusCodes []int
+}
+
+// RetryPolicy returns the retry policy configured on the provider, with the
+// defaults for the settings that are not set
+func (c *Config) RetryPolicy() *RetryPolicy {
+	p := &RetryPolicy{
+		MaxRetries:           c.RetryCount,
+		BaseDelay:            c.RetryBaseDelay,
+		MaxDelay:             c.RetryMaxDelay,
+		Jitter:               c.RetryJitter,
+		RetryableStatusCodes: c.RetryableStatusCodes,
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = DefaultRetryBaseDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DefaultRetryMaxDelay
+	}
+	if p.MaxDelay < p.BaseDelay {
+		p.MaxDelay = p.BaseDelay
+	}
+	if len(p.RetryableStatusCodes) == 0 {
+		p.RetryableStatusCodes = DefaultRetryableStatusCodes
+	}
+	return p
+}
+
+func (p *RetryPolicy) retryableStatusCode(code int) bool {
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the wait before the retry that follows the given attempt,
+// starting at 0. The Retry-After header of a 429 or 503 response is honoured
+// as is, even when it is longer than MaxDelay.
+func (p *RetryPolicy) Backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+	wait := p.MaxDelay
+	if attempt < 32 {
+		if d := p.BaseDelay << uint(attempt); d > 0 && d < p.MaxDelay {
+			wait = d
+		}
+	}
+	if p.Jitter && wait > 1 {
+		wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	}
+	return wait
+}
+
+// retryAfter parses a Retry-After header, which holds either a number of
+// seconds or an HTTP date
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(t); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+// RetryableError marks an error returned to Retry as retryable, whatever its
+// type or status code
+func RetryableError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
+// IsRetryable reports whether a failed API call should be retried: errors
+// marked with RetryableError, Bluemix request failures with a retryable status
+// code and network timeouts
+func (p *RetryPolicy) IsRetryable(err error) bool {
+	var retryable *retryableError
+	if errors.As(err, &retryable) {
+		return true
+	}
+	var bmErr bmxerror.RequestFailure
+	if errors.As(err, &bmErr) {
+		return p.retryableStatusCode(bmErr.StatusCode())
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return false
+}
+
+// Retry calls f until it succeeds, returns an error that is not retryable or
+// the policy runs out of retries. The last error is returned. Clients built
+// by the ClientSession already retry with the policy, so calls made with them
+// should only mark errors that their transport does not retry as retryable.
+func (p *RetryPolicy) Retry(f func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := f()
+		if err == nil || attempt >= p.MaxRetries || !p.IsRetryable(err) {
+			if retryable, ok := err.(*retryableError); ok {
+				return retryable.err
+			}
+			return err
+		}
+		wait := p.Backoff(attempt, nil)
+		log.Printf("[DEBUG] Retrying in %s (attempt %d of %d) after error: %s", wait, attempt+1, p.MaxRetries, err)
+		time.Sleep(wait)
+	}
+}
+
+// Client returns a copy of client that retries failed requests with the policy
+func (p *RetryPolicy) Client(client *http.Client) *http.Client {
+	if client == nil {
+		client = &http.Client{}
+	}
+	retrying := *client
+	retrying.Transport = p.Transport(client.Transport)
+	return &retrying
+}
+
+// Transport returns an http.RoundTripper that sends requests with next and
+// retries them with the policy
+func (p *RetryPolicy) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &retryTransport{policy: p, next: next}
+}
+
+// EnableRetries replaces the retries of an IBM Cloud SDK service with the policy
+func (p *RetryPolicy) EnableRetries(service *core.BaseService) {
+	service.DisableRetries()
+	service.SetHTTPClient(p.Client(service.GetHTTPClient()))
+}
+
+// retryTransport is an http.RoundTripper that retries failed requests
+type retryTransport struct {
+	policy *RetryPolicy
+	next   http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	getBody := req.GetBody
+	if req.Body != nil && req.Body != http.NoBody && getBody == nil {
+		// The body has to be sent again with every attempt
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		r := req
+		if getBody != nil && (attempt > 0 || req.GetBody == nil) {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(r)
+		if attempt >= t.policy.MaxRetries || !t.retryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.policy.Backoff(attempt, resp)
+		if err == nil {
+			log.Printf("[DEBUG] Retrying %s %s in %s (attempt %d of %d) after status %d", req.Method, req.URL.Redacted(), wait, attempt+1, t.policy.MaxRetries, resp.StatusCode)
+			io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<16))
+			resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] Retrying %s %s in %s (attempt %d of %d) after error: %s", req.Method, req.URL.Redacted(), wait, attempt+1, t.policy.MaxRetries, err)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if !idempotent(req.Method) {
+		// The API asked for the request to be sent again, so it was not run
+		if err != nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+			return false
+		}
+		_, ok := retryAfter(resp.Header.Get("Retry-After"))
+		return ok
+	}
+	if err != nil {
+		// Retrying cannot fix a certificate that is not trusted
+		var unknownAuthority x509.UnknownAuthorityError
+		var invalidCertificate x509.CertificateInvalidError
+		var invalidHostname x509.HostnameError
+		if errors.As(err, &unknownAuthority) || errors.As(err, &invalidCertificate) || errors.As(err, &invalidHostname) {
+			return false
+		}
+		return true
+	}
+	return t.policy.retryableStatusCode(resp.StatusCode)
+}
+
+// idempotent reports whether sending a request with method more than once has
+// the same effect as sending it once
+func idempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+func testRetryPolicy(maxRetries int) *RetryPolicy {
+	return (&Config{
+		RetryCount:     maxRetries,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  4 * time.Millisecond,
+	}).RetryPolicy()
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := (&Config{RetryBaseDelay: time.Second, RetryMaxDelay: 5 * time.Second}).RetryPolicy()
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := p.Backoff(attempt, nil); got != want {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, want, got)
+		}
+	}
+	if got := p.Backoff(100, nil); got != 5*time.Second {
+		t.Errorf("expected the backoff to be capped, got %s", got)
+	}
+
+	p.Jitter = true
+	for i := 0; i < 100; i++ {
+		if got := p.Backoff(2, nil); got < 2*time.Second || got > 4*time.Second {
+			t.Fatalf("expected a jittered backoff between 2s and 4s, got %s", got)
+		}
+	}
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"120"}}}
+	if got := p.Backoff(0, resp); got != 120*time.Second {
+		t.Errorf("expected Retry-After to be honoured, got %s", got)
+	}
+	resp.StatusCode = http.StatusInternalServerError
+	if got := p.Backoff(0, resp); got > time.Second {
+		t.Errorf("Retry-After must only be honoured on 429 and 503, got %s", got)
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("attempt %d got body %q", atomic.LoadInt32(&calls)+1, body)
+		}
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	client := testRetryPolicy(5).Client(&http.Client{})
+	// A reader without GetBody, like the multipart bodies of the SDKs
+	req, _ := http.NewRequest("PUT", server.URL, ioutil.NopCloser(strings.NewReader("payload")))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || calls != 3 {
+		t.Fatalf("expected success on the third attempt, got %d after %d attempts", resp.StatusCode, calls)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	client = testRetryPolicy(1).Client(&http.Client{})
+	req, _ = http.NewRequest("PUT", server.URL, strings.NewReader("payload"))
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || calls != 2 {
+		t.Fatalf("expected to give up after one retry, got %d after %d attempts", resp.StatusCode, calls)
+	}
+}
+
+func TestRetryTransportNonIdempotent(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		retryAfter string
+		attempts   int32
+	}{
+		{name: "server error", status: http.StatusInternalServerError, attempts: 1},
+		{name: "unavailable", status: http.StatusServiceUnavailable, attempts: 1},
+		{name: "unavailable with retry-after", status: http.StatusServiceUnavailable, retryAfter: "0", attempts: 2},
+		{name: "rate limited with retry-after", status: http.StatusTooManyRequests, retryAfter: "0", attempts: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&calls, 1) > 1 {
+					w.WriteHeader(http.StatusCreated)
+					return
+				}
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			resp, err := testRetryPolicy(3).Client(nil).Post(server.URL, "application/json", strings.NewReader("{}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if calls != tt.attempts {
+				t.Fatalf("expected %d attempts of the POST, got %d", tt.attempts, calls)
+			}
+		})
+	}
+
+	// A POST that fails on the connection may have been carried out already
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		hj, _ := w.(http.Hijacker)
+		conn, _, _ := hj.Hijack()
+		conn.Close()
+	}))
+	defer server.Close()
+	if _, err := testRetryPolicy(3).Client(nil).Post(server.URL, "application/json", strings.NewReader("{}")); err == nil {
+		t.Fatal("expected the connection error to be returned")
+	}
+	if calls != 1 {
+		t.Fatalf("a POST that failed on the connection must not be retried, got %d attempts", calls)
+	}
+}
+
+func TestRetryTransportStatusCodes(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	p := testRetryPolicy(3)
+	p.RetryableStatusCodes = []int{429}
+	resp, err := p.Client(nil).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls != 1 {
+		t.Fatalf("a status code that is not retryable must not be retried, got %d attempts", calls)
+	}
+}
+
+func TestRetryPolicyRetry(t *testing.T) {
+	p := testRetryPolicy(3)
+
+	calls := 0
+	err := p.Retry(func() error {
+		calls++
+		return bmxerror.NewRequestFailure("Throttled", "too many requests", 429)
+	})
+	if err == nil || calls != 4 {
+		t.Fatalf("expected 4 attempts and an error, got %d attempts and %v", calls, err)
+	}
+
+	calls = 0
+	err = p.Retry(func() error {
+		calls++
+		return bmxerror.NewRequestFailure("NotFound", "not found", 404)
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("expected a 404 not to be retried, got %d attempts", calls)
+	}
+
+	calls = 0
+	notReady := errors.New("not ready")
+	err = p.Retry(func() error {
+		calls++
+		if calls < 3 {
+			return RetryableError(notReady)
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Fatalf("expected success on the third attempt, got %d attempts and %v", calls, err)
+	}
+
+	err = p.Retry(func() error {
+		return RetryableError(notReady)
+	})
+	if err != notReady {
+		t.Fatalf("expected the unwrapped error, got %v", err)
+	}
+}
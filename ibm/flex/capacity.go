@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// CapacityChecksEnabled reports whether the capacity_checks argument of the
+// provider asks to check quotas and capacity at plan time
+func CapacityChecksEnabled(meta interface{}) bool {
+	return capacityChecks(meta) == conns.CapacityChecksError
+}
+
+// CapacityCheckFailed returns a planned change that exceeds a quota or the
+// available capacity as an error that fails the plan. CustomizeDiff cannot
+// return warnings, so this check, like the other plan-time checks of the
+// provider, e.g. the lockout check of ibm_cbr_rule or the overlap check of
+// ibm_tg_connection, can only fail the plan or be turned off.
+func CapacityCheckFailed(format string, a ...interface{}) error {
+	return fmt.Errorf("[ERROR] %s. Set capacity_checks to off in the provider to apply anyway", fmt.Sprintf(format, a...))
+}
+
+// CapacityCheckSkipped logs a check that could not be made, e.g. because the
+// API that returns the quota failed. It never fails the plan.
+func CapacityCheckSkipped(format string, a ...interface{}) {
+	log.Printf("[WARN] Skipping the capacity check: %s", fmt.Sprintf(format, a...))
+}
+
+// QuotaCheck checks that the requested amount of a quota is available, given
+// the amount used and the limit of the quota. The check passes when the usage
+// or the limit is unknown.
+func QuotaCheck(quota, unit string, requested float64, used, limit *float64) error {
+	if requested <= 0 || used == nil || limit == nil {
+		return nil
+	}
+	if *used+requested > *limit {
+		return CapacityCheckFailed("The %s is exceeded: %g%s used, %g%s requested, %g%s allowed", quota, *used, unit, requested, unit, *limit, unit)
+	}
+	return nil
+}
+
+// PISystemPoolCheck checks that the systems of the system type of a Power
+// Systems workspace have the processors and memory of an instance available
+func PISystemPoolCheck(sysType string, pools models.SystemPools, procs, mem float64) error {
+	pool, ok := pools[sysType]
+	if !ok {
+		available := make([]string, 0, len(pools))
+		for t := range pools {
+			available = append(available, t)
+		}
+		sort.Strings(available)
+		return CapacityCheckFailed("The system type %s is not available in the Power Systems workspace, the available system types are %s", sysType, strings.Join(available, ", "))
+	}
+	if pool.MaxCoresAvailable != nil && pool.MaxCoresAvailable.Cores != nil && procs > *pool.MaxCoresAvailable.Cores {
+		return CapacityCheckFailed("The %s systems have at most %g cores available, %g requested", sysType, *pool.MaxCoresAvailable.Cores, procs)
+	}
+	if pool.MaxMemoryAvailable != nil && pool.MaxMemoryAvailable.Memory != nil && mem > float64(*pool.MaxMemoryAvailable.Memory) {
+		return CapacityCheckFailed("The %s systems have at most %d GB of memory available, %g GB requested", sysType, *pool.MaxMemoryAvailable.Memory, mem)
+	}
+	return nil
+}
+
+// ISDedicatedHostCheck checks that a dedicated host supports the instance
+// profile and has the vCPUs and memory of the profile available
+func ISDedicatedHostCheck(profile *vpcv1.InstanceProfile, host *vpcv1.DedicatedHost) error {
+	profileName, hostID := stringValue(profile.Name), stringValue(host.ID)
+	supported := false
+	for _, p := range host.SupportedInstanceProfiles {
+		if p.Name != nil && *p.Name == profileName {
+			supported = true
+		}
+	}
+	if !supported {
+		return CapacityCheckFailed("The instance profile %s is not supported by the dedicated host %s", profileName, hostID)
+	}
+	if vcpu, ok := profile.VcpuCount.(*vpcv1.InstanceProfileVcpu); ok && vcpu.Value != nil && host.AvailableVcpu != nil && host.AvailableVcpu.Count != nil {
+		if *vcpu.Value > *host.AvailableVcpu.Count {
+			return CapacityCheckFailed("The dedicated host %s has %d vCPUs available, the instance profile %s needs %d", hostID, *host.AvailableVcpu.Count, profileName, *vcpu.Value)
+		}
+	}
+	if memory, ok := profile.Memory.(*vpcv1.InstanceProfileMemory); ok && memory.Value != nil && host.AvailableMemory != nil {
+		if *memory.Value > *host.AvailableMemory {
+			return CapacityCheckFailed("The dedicated host %s has %d GB of memory available, the instance profile %s needs %d GB", hostID, *host.AvailableMemory, profileName, *memory.Value)
+		}
+	}
+	return nil
+}
+
+func capacityChecks(meta interface{}) string {
+	if sess, ok := meta.(conns.ClientSession); ok {
+		return sess.CapacityChecks()
+	}
+	return conns.CapacityChecksOff
+}
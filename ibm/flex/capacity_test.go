@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// testCapacitySession is a ClientSession with only the capacity_checks of the
+// provider
+type testCapacitySession struct {
+	conns.ClientSession
+	capacityChecks string
+}
+
+func (s testCapacitySession) CapacityChecks() string {
+	return s.capacityChecks
+}
+
+func TestCapacityChecksEnabled(t *testing.T) {
+	if CapacityChecksEnabled(nil) {
+		t.Error("expected the checks to be off without a session")
+	}
+	if CapacityChecksEnabled(testCapacitySession{capacityChecks: conns.CapacityChecksOff}) {
+		t.Error("expected the checks to be off")
+	}
+	if !CapacityChecksEnabled(testCapacitySession{capacityChecks: conns.CapacityChecksError}) {
+		t.Error("expected the checks to be enabled")
+	}
+
+	err := CapacityCheckFailed("The %s quota is exceeded", "memory")
+	if err == nil || err.Error() != "[ERROR] The memory quota is exceeded. Set capacity_checks to off in the provider to apply anyway" {
+		t.Errorf("expected the failed check as an error, got %v", err)
+	}
+}
+
+// testCapacityCheck fails the test unless err is nil when want is empty, or
+// an error that contains want otherwise
+func testCapacityCheck(t *testing.T, err error, want string) {
+	t.Helper()
+	switch {
+	case want == "" && err != nil:
+		t.Errorf("expected the check to pass, got %s", err)
+	case want != "" && err == nil:
+		t.Errorf("expected the check to fail with %q", want)
+	case want != "" && !strings.Contains(err.Error(), want):
+		t.Errorf("expected the check to fail with %q, got %s", want, err)
+	}
+}
+
+func TestQuotaCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested float64
+		used      *float64
+		limit     *float64
+		want      string
+	}{
+		{name: "within the quota", requested: 2, used: core.Float64Ptr(4), limit: core.Float64Ptr(8)},
+		{name: "up to the quota", requested: 4, used: core.Float64Ptr(4), limit: core.Float64Ptr(8)},
+		{name: "over the quota", requested: 4.5, used: core.Float64Ptr(4), limit: core.Float64Ptr(8), want: "The processors quota is exceeded: 4 used, 4.5 requested, 8 allowed"},
+		{name: "nothing requested", requested: 0, used: core.Float64Ptr(10), limit: core.Float64Ptr(8)},
+		{name: "shrinking", requested: -2, used: core.Float64Ptr(10), limit: core.Float64Ptr(8)},
+		{name: "unknown usage", requested: 4, limit: core.Float64Ptr(8)},
+		{name: "unknown limit", requested: 4, used: core.Float64Ptr(4)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testCapacityCheck(t, QuotaCheck("processors quota", "", tt.requested, tt.used, tt.limit), tt.want)
+		})
+	}
+}
+
+func TestPISystemPoolCheck(t *testing.T) {
+	pools := models.SystemPools{
+		"s922": {
+			MaxCoresAvailable:  &models.System{Cores: core.Float64Ptr(8)},
+			MaxMemoryAvailable: &models.System{Memory: core.Int64Ptr(256)},
+		},
+		"e980": {},
+	}
+	tests := []struct {
+		name    string
+		sysType string
+		procs   float64
+		mem     float64
+		want    string
+	}{
+		{name: "available", sysType: "s922", procs: 8, mem: 256},
+		{name: "too many cores", sysType: "s922", procs: 8.25, mem: 16, want: "The s922 systems have at most 8 cores available, 8.25 requested"},
+		{name: "too much memory", sysType: "s922", procs: 1, mem: 512, want: "The s922 systems have at most 256 GB of memory available, 512 GB requested"},
+		{name: "unknown availability", sysType: "e980", procs: 64, mem: 4096},
+		{name: "unavailable system type", sysType: "e880", procs: 1, mem: 2, want: "The system type e880 is not available in the Power Systems workspace, the available system types are e980, s922"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testCapacityCheck(t, PISystemPoolCheck(tt.sysType, pools, tt.procs, tt.mem), tt.want)
+		})
+	}
+}
+
+func TestISDedicatedHostCheck(t *testing.T) {
+	profile := &vpcv1.InstanceProfile{
+		Name:      core.StringPtr("bx2-4x16"),
+		VcpuCount: &vpcv1.InstanceProfileVcpu{Value: core.Int64Ptr(4)},
+		Memory:    &vpcv1.InstanceProfileMemory{Value: core.Int64Ptr(16)},
+	}
+	host := func(profiles []string, vcpu, memory int64) *vpcv1.DedicatedHost {
+		h := &vpcv1.DedicatedHost{
+			ID:              core.StringPtr("host-1"),
+			AvailableVcpu:   &vpcv1.Vcpu{Count: core.Int64Ptr(vcpu)},
+			AvailableMemory: core.Int64Ptr(memory),
+		}
+		for _, p := range profiles {
+			h.SupportedInstanceProfiles = append(h.SupportedInstanceProfiles, vpcv1.InstanceProfileReference{Name: core.StringPtr(p)})
+		}
+		return h
+	}
+	tests := []struct {
+		name string
+		host *vpcv1.DedicatedHost
+		want string
+	}{
+		{name: "available", host: host([]string{"bx2-2x8", "bx2-4x16"}, 4, 16)},
+		{name: "unsupported profile", host: host([]string{"bx2-2x8"}, 16, 64), want: "The instance profile bx2-4x16 is not supported by the dedicated host host-1"},
+		{name: "not enough vCPUs", host: host([]string{"bx2-4x16"}, 2, 64), want: "The dedicated host host-1 has 2 vCPUs available, the instance profile bx2-4x16 needs 4"},
+		{name: "not enough memory", host: host([]string{"bx2-4x16"}, 16, 8), want: "The dedicated host host-1 has 8 GB of memory available, the instance profile bx2-4x16 needs 16 GB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testCapacityCheck(t, ISDedicatedHostCheck(profile, tt.host), tt.want)
+		})
+	}
+}
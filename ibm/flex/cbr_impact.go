@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+// CbrAddress is an address of a context-based restrictions network zone
+type CbrAddress struct {
+	Type  string
+	Value string
+	Ref   *contextbasedrestrictionsv1.ServiceRefValue
+}
+
+// String returns the address as type:value
+func (a CbrAddress) String() string {
+	if a.Ref != nil {
+		ref := []string{}
+		for _, v := range []*string{a.Ref.AccountID, a.Ref.ServiceType, a.Ref.ServiceName, a.Ref.ServiceInstance, a.Ref.Location} {
+			if v != nil && *v != "" {
+				ref = append(ref, *v)
+			}
+		}
+		return fmt.Sprintf("%s:%s", a.Type, strings.Join(ref, "/"))
+	}
+	return fmt.Sprintf("%s:%s", a.Type, a.Value)
+}
+
+// CbrZone is a network zone with its addresses resolved
+type CbrZone struct {
+	ID        string
+	Name      string
+	Addresses []CbrAddress
+	Excluded  []CbrAddress
+}
+
+// NewCbrZone converts a zone returned by the context-based restrictions API
+func NewCbrZone(zone *contextbasedrestrictionsv1.Zone) CbrZone {
+	z := CbrZone{}
+	if zone.ID != nil {
+		z.ID = *zone.ID
+	}
+	if zone.Name != nil {
+		z.Name = *zone.Name
+	}
+	for _, a := range zone.Addresses {
+		z.Addresses = append(z.Addresses, newCbrAddress(a))
+	}
+	for _, a := range zone.Excluded {
+		z.Excluded = append(z.Excluded, newCbrAddress(a))
+	}
+	return z
+}
+
+func newCbrAddress(address contextbasedrestrictionsv1.AddressIntf) CbrAddress {
+	a := CbrAddress{}
+	switch v := address.(type) {
+	case *contextbasedrestrictionsv1.AddressIPAddress:
+		a.Type, a.Value = stringValue(v.Type), stringValue(v.Value)
+	case *contextbasedrestrictionsv1.AddressIPAddressRange:
+		a.Type, a.Value = stringValue(v.Type), stringValue(v.Value)
+	case *contextbasedrestrictionsv1.AddressSubnet:
+		a.Type, a.Value = stringValue(v.Type), stringValue(v.Value)
+	case *contextbasedrestrictionsv1.AddressVPC:
+		a.Type, a.Value = stringValue(v.Type), stringValue(v.Value)
+	case *contextbasedrestrictionsv1.AddressServiceRef:
+		a.Type, a.Ref = stringValue(v.Type), v.Ref
+	case *contextbasedrestrictionsv1.Address:
+		a.Type, a.Value, a.Ref = stringValue(v.Type), stringValue(v.Value), v.Ref
+	}
+	return a
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// CbrCaller is the context a request is made from. EndpointType defaults to
+// public; ServiceName, ServiceInstance and AccountID describe a calling service
+// and are matched against serviceRef addresses.
+type CbrCaller struct {
+	Name            string
+	IPAddress       string
+	VPC             string
+	EndpointType    string
+	ServiceName     string
+	ServiceInstance string
+	AccountID       string
+}
+
+// CbrDecision is the result of EvaluateCbrRule; ZoneID is set when the caller
+// is allowed from a network zone.
+type CbrDecision struct {
+	Allowed bool
+	ZoneID  string
+	Reason  string
+}
+
+// CbrRuleZoneIDs returns the IDs of the network zones referenced by the
+// contexts of a rule, in order of appearance
+func CbrRuleZoneIDs(contexts []contextbasedrestrictionsv1.RuleContext) []string {
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, c := range contexts {
+		for _, a := range c.Attributes {
+			if stringValue(a.Name) != "networkZoneId" {
+				continue
+			}
+			for _, id := range splitCbrValue(stringValue(a.Value)) {
+				if !seen[id] {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+	return ids
+}
+
+// EvaluateCbrRule reports whether a rule with the contexts allows the caller.
+// The caller is allowed by the first context whose attributes all match; a
+// rule without contexts denies every caller. The resources of the rule are not
+// evaluated. zones must contain every zone returned by CbrRuleZoneIDs.
+func EvaluateCbrRule(contexts []contextbasedrestrictionsv1.RuleContext, zones map[string]CbrZone, caller CbrCaller) (CbrDecision, error) {
+	endpointType := caller.EndpointType
+	if endpointType == "" {
+		endpointType = "public"
+	}
+
+	decision := CbrDecision{
+		Reason: "the rule has no contexts",
+	}
+	for _, c := range contexts {
+		allowed, zoneID := true, ""
+		for _, a := range c.Attributes {
+			values := splitCbrValue(stringValue(a.Value))
+			switch name := stringValue(a.Name); name {
+			case "networkZoneId":
+				zoneID = ""
+				for _, id := range values {
+					zone, ok := zones[id]
+					if !ok {
+						return CbrDecision{}, fmt.Errorf("[ERROR] network zone %s of the rule is not resolved", id)
+					}
+					if cbrZoneContains(zone, caller) {
+						zoneID = id
+						break
+					}
+				}
+				if zoneID == "" {
+					allowed = false
+					decision.Reason = fmt.Sprintf("the caller is not in network zone %s", strings.Join(values, " or "))
+				}
+			case "endpointType":
+				if !containsCbrValue(values, endpointType) {
+					allowed = false
+					decision.Reason = fmt.Sprintf("the %s endpoint is not allowed", endpointType)
+				}
+			default:
+				allowed = false
+				decision.Reason = fmt.Sprintf("context attribute %s cannot be evaluated", name)
+			}
+			if !allowed {
+				break
+			}
+		}
+		if allowed {
+			reason := "the caller matches a context without a network zone"
+			if zoneID != "" {
+				reason = fmt.Sprintf("the caller is in network zone %s", zoneID)
+			}
+			return CbrDecision{Allowed: true, ZoneID: zoneID, Reason: reason}, nil
+		}
+	}
+	return decision, nil
+}
+
+func cbrZoneContains(zone CbrZone, caller CbrCaller) bool {
+	for _, a := range zone.Excluded {
+		if cbrAddressContains(a, caller) {
+			return false
+		}
+	}
+	for _, a := range zone.Addresses {
+		if cbrAddressContains(a, caller) {
+			return true
+		}
+	}
+	return false
+}
+
+func cbrAddressContains(a CbrAddress, caller CbrCaller) bool {
+	switch a.Type {
+	case contextbasedrestrictionsv1.AddressTypeIpaddressConst:
+		ip, value := net.ParseIP(caller.IPAddress), net.ParseIP(a.Value)
+		return ip != nil && value != nil && ip.Equal(value)
+	case contextbasedrestrictionsv1.AddressTypeIprangeConst:
+		ip := net.ParseIP(caller.IPAddress)
+		bounds := strings.SplitN(a.Value, "-", 2)
+		if ip == nil || len(bounds) != 2 {
+			return false
+		}
+		first, last := net.ParseIP(strings.TrimSpace(bounds[0])), net.ParseIP(strings.TrimSpace(bounds[1]))
+		if first == nil || last == nil {
+			return false
+		}
+		return bytes.Compare(ip.To16(), first.To16()) >= 0 && bytes.Compare(ip.To16(), last.To16()) <= 0
+	case contextbasedrestrictionsv1.AddressTypeSubnetConst:
+		ip := net.ParseIP(caller.IPAddress)
+		_, subnet, err := net.ParseCIDR(a.Value)
+		return ip != nil && err == nil && subnet.Contains(ip)
+	case contextbasedrestrictionsv1.AddressTypeVPCConst:
+		return caller.VPC != "" && caller.VPC == a.Value
+	case contextbasedrestrictionsv1.AddressTypeServicerefConst:
+		if a.Ref == nil || caller.ServiceName == "" {
+			return false
+		}
+		if stringValue(a.Ref.AccountID) != caller.AccountID || stringValue(a.Ref.ServiceName) != caller.ServiceName {
+			return false
+		}
+		instance := stringValue(a.Ref.ServiceInstance)
+		return instance == "" || instance == caller.ServiceInstance
+	}
+	return false
+}
+
+func splitCbrValue(value string) []string {
+	values := []string{}
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func containsCbrValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
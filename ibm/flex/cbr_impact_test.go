@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+func testCbrRuleContext(attributes map[string]string) contextbasedrestrictionsv1.RuleContext {
+	c := contextbasedrestrictionsv1.RuleContext{}
+	for _, name := range []string{"networkZoneId", "endpointType"} {
+		if value, ok := attributes[name]; ok {
+			c.Attributes = append(c.Attributes, contextbasedrestrictionsv1.RuleContextAttribute{Name: core.StringPtr(name), Value: core.StringPtr(value)})
+		}
+	}
+	return c
+}
+
+func TestNewCbrZone(t *testing.T) {
+	zone := NewCbrZone(&contextbasedrestrictionsv1.Zone{
+		ID:   core.StringPtr("zone-1"),
+		Name: core.StringPtr("pipeline"),
+		Addresses: []contextbasedrestrictionsv1.AddressIntf{
+			&contextbasedrestrictionsv1.AddressIPAddress{Type: core.StringPtr("ipAddress"), Value: core.StringPtr("169.23.56.234")},
+			&contextbasedrestrictionsv1.AddressServiceRef{Type: core.StringPtr("serviceRef"), Ref: &contextbasedrestrictionsv1.ServiceRefValue{AccountID: core.StringPtr("acc"), ServiceName: core.StringPtr("containers-kubernetes")}},
+		},
+		Excluded: []contextbasedrestrictionsv1.AddressIntf{
+			&contextbasedrestrictionsv1.AddressSubnet{Type: core.StringPtr("subnet"), Value: core.StringPtr("169.23.56.0/30")},
+		},
+	})
+
+	addresses := []string{}
+	for _, a := range zone.Addresses {
+		addresses = append(addresses, a.String())
+	}
+	if want := []string{"ipAddress:169.23.56.234", "serviceRef:acc/containers-kubernetes"}; !reflect.DeepEqual(addresses, want) {
+		t.Errorf("expected addresses %v, got %v", want, addresses)
+	}
+	if zone.ID != "zone-1" || zone.Name != "pipeline" || len(zone.Excluded) != 1 || zone.Excluded[0].String() != "subnet:169.23.56.0/30" {
+		t.Errorf("unexpected zone %+v", zone)
+	}
+}
+
+func TestEvaluateCbrRule(t *testing.T) {
+	zones := map[string]CbrZone{
+		"office": {
+			ID: "office",
+			Addresses: []CbrAddress{
+				{Type: "ipAddress", Value: "169.23.56.234"},
+				{Type: "ipRange", Value: "169.23.22.0-169.23.22.255"},
+			},
+		},
+		"vpc": {
+			ID: "vpc",
+			Addresses: []CbrAddress{
+				{Type: "subnet", Value: "10.240.0.0/24"},
+				{Type: "vpc", Value: "crn:v1:bluemix:public:is:us-south:a/acc::vpc:r006-1234"},
+				{Type: "serviceRef", Ref: &contextbasedrestrictionsv1.ServiceRefValue{AccountID: core.StringPtr("acc"), ServiceName: core.StringPtr("schematics")}},
+			},
+			Excluded: []CbrAddress{
+				{Type: "ipAddress", Value: "10.240.0.8"},
+			},
+		},
+	}
+	contexts := []contextbasedrestrictionsv1.RuleContext{
+		testCbrRuleContext(map[string]string{"networkZoneId": "office", "endpointType": "public"}),
+		testCbrRuleContext(map[string]string{"networkZoneId": "vpc", "endpointType": "private,direct"}),
+	}
+
+	tests := []struct {
+		name    string
+		caller  CbrCaller
+		allowed bool
+		zoneID  string
+		reason  string
+	}{
+		{
+			name:    "ip address on the public endpoint",
+			caller:  CbrCaller{IPAddress: "169.23.56.234"},
+			allowed: true,
+			zoneID:  "office",
+			reason:  "the caller is in network zone office",
+		},
+		{
+			name:    "ip range",
+			caller:  CbrCaller{IPAddress: "169.23.22.10", EndpointType: "public"},
+			allowed: true,
+			zoneID:  "office",
+		},
+		{
+			name:   "ip address on the private endpoint",
+			caller: CbrCaller{IPAddress: "169.23.56.234", EndpointType: "private"},
+			reason: "the caller is not in network zone vpc",
+		},
+		{
+			name:    "subnet on the private endpoint",
+			caller:  CbrCaller{IPAddress: "10.240.0.5", EndpointType: "private"},
+			allowed: true,
+			zoneID:  "vpc",
+		},
+		{
+			name:   "excluded address",
+			caller: CbrCaller{IPAddress: "10.240.0.8", EndpointType: "private"},
+			reason: "the caller is not in network zone vpc",
+		},
+		{
+			name:    "vpc",
+			caller:  CbrCaller{VPC: "crn:v1:bluemix:public:is:us-south:a/acc::vpc:r006-1234", EndpointType: "direct"},
+			allowed: true,
+			zoneID:  "vpc",
+		},
+		{
+			name:   "vpc on the public endpoint",
+			caller: CbrCaller{VPC: "crn:v1:bluemix:public:is:us-south:a/acc::vpc:r006-1234"},
+			reason: "the public endpoint is not allowed",
+		},
+		{
+			name:    "service reference",
+			caller:  CbrCaller{ServiceName: "schematics", AccountID: "acc", EndpointType: "private"},
+			allowed: true,
+			zoneID:  "vpc",
+		},
+		{
+			name:   "service reference of another account",
+			caller: CbrCaller{ServiceName: "schematics", AccountID: "other", EndpointType: "private"},
+			reason: "the caller is not in network zone vpc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision, err := EvaluateCbrRule(contexts, zones, tt.caller)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decision.Allowed != tt.allowed || decision.ZoneID != tt.zoneID {
+				t.Errorf("expected allowed %t in zone %q, got %+v", tt.allowed, tt.zoneID, decision)
+			}
+			if tt.reason != "" && decision.Reason != tt.reason {
+				t.Errorf("expected reason %q, got %q", tt.reason, decision.Reason)
+			}
+		})
+	}
+}
+
+func TestEvaluateCbrRuleWithoutZones(t *testing.T) {
+	decision, err := EvaluateCbrRule(nil, nil, CbrCaller{IPAddress: "169.23.56.234"})
+	if err != nil || decision.Allowed || decision.Reason != "the rule has no contexts" {
+		t.Errorf("expected a rule without contexts to deny, got %+v, %v", decision, err)
+	}
+
+	endpointOnly := []contextbasedrestrictionsv1.RuleContext{testCbrRuleContext(map[string]string{"endpointType": "private"})}
+	decision, err = EvaluateCbrRule(endpointOnly, nil, CbrCaller{EndpointType: "private"})
+	if err != nil || !decision.Allowed || decision.ZoneID != "" {
+		t.Errorf("expected a context without zone to allow the private endpoint, got %+v, %v", decision, err)
+	}
+
+	unresolved := []contextbasedrestrictionsv1.RuleContext{testCbrRuleContext(map[string]string{"networkZoneId": "missing"})}
+	if _, err := EvaluateCbrRule(unresolved, nil, CbrCaller{}); err == nil {
+		t.Error("expected an error for a zone that is not resolved")
+	}
+}
+
+func TestCbrRuleZoneIDs(t *testing.T) {
+	contexts := []contextbasedrestrictionsv1.RuleContext{
+		testCbrRuleContext(map[string]string{"networkZoneId": "a, b", "endpointType": "public"}),
+		testCbrRuleContext(map[string]string{"networkZoneId": "b,c"}),
+	}
+	if ids, want := CbrRuleZoneIDs(contexts), []string{"a", "b", "c"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("expected %v, got %v", want, ids)
+	}
+}
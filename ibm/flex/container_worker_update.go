@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+// ContainerWorkerUpdateStrategy is how many worker nodes of a cluster are
+// replaced at the same time when they are updated, and in which order
+type ContainerWorkerUpdateStrategy struct {
+	// MaxUnavailable is the number of worker nodes of a pool replaced at the
+	// same time, or a percentage of the worker nodes of the pool when Percent
+	// is set
+	MaxUnavailable int
+	Percent        bool
+
+	// PoolOrder lists the worker pools updated first, in order. The other
+	// pools are updated next, in the order of their names.
+	PoolOrder []string
+}
+
+// ParseContainerWorkerUpdateMaxUnavailable parses a max_unavailable, either a
+// number of worker nodes or a percentage of the worker nodes of a pool such as
+// 25%
+func ParseContainerWorkerUpdateMaxUnavailable(v string) (int, bool, error) {
+	percent := strings.HasSuffix(v, "%")
+	n, err := strconv.Atoi(strings.TrimSuffix(v, "%"))
+	if err != nil || n < 1 || (percent && n > 100) {
+		return 0, false, fmt.Errorf("must be a number of worker nodes, or a percentage of them between 1%% and 100%%, got %q", v)
+	}
+	return n, percent, nil
+}
+
+// BatchSize returns the number of worker nodes of a pool of size worker nodes
+// that are replaced at the same time. A percentage is rounded down, but at
+// least one worker node is replaced at a time.
+func (s ContainerWorkerUpdateStrategy) BatchSize(size int) int {
+	n := s.MaxUnavailable
+	if s.Percent {
+		n = size * s.MaxUnavailable / 100
+	}
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// ContainerWorkerUpdateBatches groups the outdated worker nodes in the batches
+// replaced at the same time. The size of the batches of a pool is computed
+// from all the worker nodes of the pool, including those already updated. The
+// pools are updated one after the other, in the order of the strategy first.
+func ContainerWorkerUpdateBatches(workers, outdated []containerv2.Worker, strategy ContainerWorkerUpdateStrategy) [][]containerv2.Worker {
+	poolSize := make(map[string]int)
+	for _, worker := range workers {
+		poolSize[worker.PoolName]++
+	}
+	byPool := make(map[string][]containerv2.Worker)
+	var pools []string
+	for _, worker := range outdated {
+		if _, ok := byPool[worker.PoolName]; !ok {
+			pools = append(pools, worker.PoolName)
+		}
+		byPool[worker.PoolName] = append(byPool[worker.PoolName], worker)
+	}
+	order := make(map[string]int)
+	for i, pool := range strategy.PoolOrder {
+		if _, ok := order[pool]; !ok {
+			order[pool] = i
+		}
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		oi, iok := order[pools[i]]
+		oj, jok := order[pools[j]]
+		if iok != jok {
+			return iok
+		}
+		if iok {
+			return oi < oj
+		}
+		return pools[i] < pools[j]
+	})
+
+	var batches [][]containerv2.Worker
+	for _, pool := range pools {
+		size := strategy.BatchSize(poolSize[pool])
+		poolWorkers := byPool[pool]
+		for len(poolWorkers) > 0 {
+			n := size
+			if n > len(poolWorkers) {
+				n = len(poolWorkers)
+			}
+			batches = append(batches, poolWorkers[:n])
+			poolWorkers = poolWorkers[n:]
+		}
+	}
+	return batches
+}
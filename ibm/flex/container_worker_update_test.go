@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+func TestParseContainerWorkerUpdateMaxUnavailable(t *testing.T) {
+	tests := []struct {
+		value   string
+		n       int
+		percent bool
+		wantErr bool
+	}{
+		{value: "1", n: 1},
+		{value: "10", n: 10},
+		{value: "1%", n: 1, percent: true},
+		{value: "100%", n: 100, percent: true},
+		{value: "0", wantErr: true},
+		{value: "0%", wantErr: true},
+		{value: "101%", wantErr: true},
+		{value: "-1", wantErr: true},
+		{value: "", wantErr: true},
+		{value: "25 %", wantErr: true},
+		{value: "one", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			n, percent, err := ParseContainerWorkerUpdateMaxUnavailable(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %d, %t", n, percent)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != tt.n || percent != tt.percent {
+				t.Errorf("expected %d, %t, got %d, %t", tt.n, tt.percent, n, percent)
+			}
+		})
+	}
+}
+
+func TestContainerWorkerUpdateStrategyBatchSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy ContainerWorkerUpdateStrategy
+		size     int
+		want     int
+	}{
+		{name: "count", strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 2}, size: 5, want: 2},
+		{name: "count larger than the pool", strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 10}, size: 3, want: 10},
+		{name: "100%", strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 100, Percent: true}, size: 3, want: 3},
+		{name: "percentage rounded down", strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 50, Percent: true}, size: 5, want: 2},
+		{name: "percentage rounded down to zero", strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 25, Percent: true}, size: 3, want: 1},
+		{name: "1% of a small pool", strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 1, Percent: true}, size: 1, want: 1},
+		{name: "empty pool", strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 50, Percent: true}, size: 0, want: 1},
+		{name: "unset", strategy: ContainerWorkerUpdateStrategy{}, size: 3, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.strategy.BatchSize(tt.size); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestContainerWorkerUpdateBatches(t *testing.T) {
+	worker := func(id, pool string) containerv2.Worker {
+		return containerv2.Worker{ID: id, PoolName: pool}
+	}
+	// The default pool has 4 worker nodes, a1 of which is already updated
+	a1, a2, a3, a4 := worker("a1", "default"), worker("a2", "default"), worker("a3", "default"), worker("a4", "default")
+	b1, b2 := worker("b1", "edge"), worker("b2", "edge")
+	c1 := worker("c1", "gpu")
+	workers := []containerv2.Worker{a1, a2, a3, a4, b1, b2, c1}
+	outdated := []containerv2.Worker{a2, a3, a4, b1, b2, c1}
+
+	tests := []struct {
+		name     string
+		outdated []containerv2.Worker
+		strategy ContainerWorkerUpdateStrategy
+		want     [][]containerv2.Worker
+	}{
+		{
+			name:     "one at a time in the order of the pool names",
+			outdated: outdated,
+			strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 1},
+			want:     [][]containerv2.Worker{{a2}, {a3}, {a4}, {b1}, {b2}, {c1}},
+		},
+		{
+			name:     "count larger than the pools",
+			outdated: outdated,
+			strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 5},
+			want:     [][]containerv2.Worker{{a2, a3, a4}, {b1, b2}, {c1}},
+		},
+		{
+			name:     "percentage of the pool including the updated worker nodes",
+			outdated: outdated,
+			strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 50, Percent: true},
+			want:     [][]containerv2.Worker{{a2, a3}, {a4}, {b1}, {b2}, {c1}},
+		},
+		{
+			name:     "100%",
+			outdated: outdated,
+			strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 100, Percent: true},
+			want:     [][]containerv2.Worker{{a2, a3, a4}, {b1, b2}, {c1}},
+		},
+		{
+			name:     "pool order first",
+			outdated: outdated,
+			strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 2, PoolOrder: []string{"gpu", "edge", "gpu"}},
+			want:     [][]containerv2.Worker{{c1}, {b1, b2}, {a2, a3}, {a4}},
+		},
+		{
+			name:     "pool order of pools without outdated worker nodes",
+			outdated: []containerv2.Worker{a2},
+			strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 1, PoolOrder: []string{"edge"}},
+			want:     [][]containerv2.Worker{{a2}},
+		},
+		{
+			name:     "all updated",
+			strategy: ContainerWorkerUpdateStrategy{MaxUnavailable: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ContainerWorkerUpdateBatches(workers, tt.outdated, tt.strategy)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
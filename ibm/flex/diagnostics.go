@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// APIError describes a failed API call with what is needed to act on it: the
+// operation, the resource, the HTTP status and the request and correlation IDs
+// that IBM Cloud support asks for in a support case.
+//
+// It is built with NewAPIError, e.g.
+//
+//	vpc, response, err := sess.CreateVPC(options)
+//	if err != nil {
+//		return flex.NewAPIError(err, response).WithOperation("CreateVPC").WithResource("ibm_is_vpc", d.Id()).Diagnostics()
+//	}
+//
+// and can be returned either as diag.Diagnostics or as an error.
+type APIError struct {
+	Err          error
+	Response     *core.DetailedResponse
+	Operation    string
+	ResourceType string
+	ResourceID   string
+}
+
+// NewAPIError returns the APIError of err, the error of an IBM Cloud SDK
+// call along with its response, or a bluemix-go error with a nil response
+func NewAPIError(err error, response *core.DetailedResponse) *APIError {
+	return &APIError{Err: err, Response: response}
+}
+
+// WithOperation sets the API operation that failed, e.g. CreateVPC
+func (e *APIError) WithOperation(operation string) *APIError {
+	e.Operation = operation
+	return e
+}
+
+// WithResource sets the type of the resource, e.g. ibm_is_vpc, and its ID,
+// which is empty when it is not created yet
+func (e *APIError) WithResource(resourceType, id string) *APIError {
+	e.ResourceType = resourceType
+	e.ResourceID = id
+	return e
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// StatusCode returns the HTTP status of the response, or 0 when unknown
+func (e *APIError) StatusCode() int {
+	if e.Response != nil && e.Response.StatusCode != 0 {
+		return e.Response.StatusCode
+	}
+	var bmErr bmxerror.RequestFailure
+	if errors.As(e.Err, &bmErr) {
+		return bmErr.StatusCode()
+	}
+	return 0
+}
+
+func (e *APIError) header(names ...string) string {
+	if e.Response == nil {
+		return ""
+	}
+	for _, name := range names {
+		if v := e.Response.GetHeaders().Get(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// result returns the top level field of the JSON error returned by the API
+func (e *APIError) result(field string) string {
+	if e.Response == nil {
+		return ""
+	}
+	if result, ok := e.Response.Result.(map[string]interface{}); ok {
+		if v, ok := result[field].(string); ok {
+			return v
+		}
+	}
+	return ""
+}
+
+// RequestID returns the ID of the failed request
+func (e *APIError) RequestID() string {
+	if id := e.header("X-Request-ID"); id != "" {
+		return id
+	}
+	// The VPC API returns the request ID as the trace of the error
+	return e.result("trace")
+}
+
+// CorrelationID returns the ID that correlates the failed request with the
+// requests it made to other services
+func (e *APIError) CorrelationID() string {
+	return e.header("X-Correlation-ID", "Transaction-Id", "X-Global-Transaction-Id")
+}
+
+// Code returns the error code of the API, e.g. over_quota
+func (e *APIError) Code() string {
+	var bmErr bmxerror.Error
+	if errors.As(e.Err, &bmErr) {
+		return bmErr.Code()
+	}
+	if e.Response == nil {
+		return ""
+	}
+	result, ok := e.Response.Result.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if errs, ok := result["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			if code, ok := first["code"].(string); ok {
+				return code
+			}
+		}
+	}
+	return e.result("code")
+}
+
+// Hint returns what usually fixes the error, or an empty string
+func (e *APIError) Hint() string {
+	status := e.StatusCode()
+	text := strings.ToLower(e.Code() + " " + e.message())
+	switch {
+	case strings.Contains(text, "quota") || strings.Contains(text, "limit_exceeded") || strings.Contains(text, "limit exceeded"):
+		return "The account has reached a quota for this kind of resource. Delete the resources that are not used or request a quota increase with a support case."
+	case status == http.StatusUnauthorized:
+		return "The credentials of the provider are not valid or have expired. Check ibmcloud_api_key, or iaas_classic_api_key for classic infrastructure."
+	case status == http.StatusForbidden:
+		return "The user or service ID of the API key is not authorized for this operation. Check its IAM access policies for the service, the resource group and the resource."
+	case status == http.StatusConflict:
+		return "The resource is being changed by another operation or is in a state that does not allow this operation. Retry once the other operation completes."
+	case status == http.StatusTooManyRequests:
+		return "The API is rate limiting the requests. Increase max_retries or retry_max_delay, or lower the parallelism of terraform."
+	case status >= http.StatusInternalServerError:
+		return "The service failed to process the request. If the error persists, open a support case with the request ID."
+	}
+	return ""
+}
+
+func (e *APIError) message() string {
+	if e.Err == nil {
+		return ""
+	}
+	var bmErr bmxerror.Error
+	if errors.As(e.Err, &bmErr) && bmErr.Description() != "" {
+		return bmErr.Description()
+	}
+	return e.Err.Error()
+}
+
+// Summary returns the one line description of the error
+func (e *APIError) Summary() string {
+	operation := e.Operation
+	if operation == "" {
+		operation = "API request"
+	}
+	if status := e.StatusCode(); status != 0 {
+		return fmt.Sprintf("[ERROR] %s failed with status %d: %s", operation, status, e.message())
+	}
+	return fmt.Sprintf("[ERROR] %s failed: %s", operation, e.message())
+}
+
+// Detail returns the details of the error, one per line
+func (e *APIError) Detail() string {
+	var lines []string
+	add := func(name, value string) {
+		if value != "" {
+			lines = append(lines, fmt.Sprintf("%s: %s", name, value))
+		}
+	}
+	if e.ResourceType != "" && e.ResourceID != "" {
+		add("Resource", fmt.Sprintf("%s (%s)", e.ResourceType, e.ResourceID))
+	} else {
+		add("Resource", e.ResourceType)
+	}
+	add("Operation", e.Operation)
+	if status := e.StatusCode(); status != 0 {
+		add("Status", fmt.Sprintf("%d %s", status, http.StatusText(status)))
+	}
+	add("Error code", e.Code())
+	add("Request ID", e.RequestID())
+	add("Correlation ID", e.CorrelationID())
+	add("Hint", e.Hint())
+	return strings.Join(lines, "\n")
+}
+
+func (e *APIError) Error() string {
+	if detail := e.Detail(); detail != "" {
+		return e.Summary() + "\n" + detail
+	}
+	return e.Summary()
+}
+
+// Diagnostics returns the error as diagnostics
+func (e *APIError) Diagnostics() diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  e.Summary(),
+			Detail:   e.Detail(),
+		},
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestAPIErrorFromDetailedResponse(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: http.StatusBadRequest,
+		Headers:    http.Header{"X-Correlation-Id": {"corr-1"}},
+		Result: map[string]interface{}{
+			"errors": []interface{}{
+				map[string]interface{}{"code": "over_quota", "message": "Quota exceeded for VPCs"},
+			},
+			"trace": "trace-1",
+		},
+	}
+	e := NewAPIError(errors.New("Quota exceeded for VPCs"), response).WithOperation("CreateVPC").WithResource("ibm_is_vpc", "")
+
+	if e.StatusCode() != 400 || e.RequestID() != "trace-1" || e.CorrelationID() != "corr-1" || e.Code() != "over_quota" {
+		t.Fatalf("unexpected status %d, request ID %q, correlation ID %q or code %q", e.StatusCode(), e.RequestID(), e.CorrelationID(), e.Code())
+	}
+	if want := "[ERROR] CreateVPC failed with status 400: Quota exceeded for VPCs"; e.Summary() != want {
+		t.Errorf("expected summary %q, got %q", want, e.Summary())
+	}
+	for _, want := range []string{"Resource: ibm_is_vpc\n", "Status: 400 Bad Request", "Request ID: trace-1", "Correlation ID: corr-1", "Hint: The account has reached a quota"} {
+		if !strings.Contains(e.Detail(), want) {
+			t.Errorf("expected %q in the detail:\n%s", want, e.Detail())
+		}
+	}
+
+	diags := e.Diagnostics()
+	if len(diags) != 1 || diags[0].Severity != diag.Error || diags[0].Summary != e.Summary() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	response.Headers.Set("X-Request-Id", "req-1")
+	if e.RequestID() != "req-1" {
+		t.Errorf("expected the X-Request-ID header to take precedence, got %q", e.RequestID())
+	}
+}
+
+func TestAPIErrorFromBluemixError(t *testing.T) {
+	err := bmxerror.NewRequestFailure("Forbidden", "You are not authorized", 403)
+	e := NewAPIError(err, nil).WithOperation("CreateWhitelist").WithResource("ibm_database", "crn:v1:db")
+
+	if e.StatusCode() != 403 || e.Code() != "Forbidden" || e.RequestID() != "" {
+		t.Fatalf("unexpected status %d, code %q or request ID %q", e.StatusCode(), e.Code(), e.RequestID())
+	}
+	if !strings.Contains(e.Error(), "Resource: ibm_database (crn:v1:db)") || !strings.Contains(e.Error(), "IAM access policies") {
+		t.Errorf("unexpected error:\n%s", e.Error())
+	}
+	if !errors.Is(e, err) {
+		t.Error("expected the APIError to wrap the error")
+	}
+
+	e = NewAPIError(errors.New("connection refused"), nil)
+	if e.Error() != "[ERROR] API request failed: connection refused" {
+		t.Errorf("unexpected error %q", e.Error())
+	}
+}
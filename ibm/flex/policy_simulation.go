@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// Decisions of SimulateAccess
+const (
+	SimulatedAllow = "allow"
+	SimulatedDeny  = "deny"
+)
+
+// SimulatedPolicy is an access policy evaluated by SimulateAccess. A policy
+// without subjects applies to every subject.
+type SimulatedPolicy struct {
+	ID        string
+	Subjects  []string
+	Roles     []string
+	Resources []iampolicymanagementv1.PolicyResource
+}
+
+// SimulatedAccess asks whether one of Subjects, typically an IAM ID and the
+// access groups it is a member of, can perform Action on the resource that is
+// described by ResourceAttributes and ResourceTags.
+type SimulatedAccess struct {
+	Subjects           []string
+	Action             string
+	ResourceAttributes map[string]string
+	ResourceTags       map[string]string
+}
+
+// SimulatedDecision is the result of SimulateAccess; PolicyID and Role are set
+// when the access is allowed.
+type SimulatedDecision struct {
+	Decision string
+	PolicyID string
+	Role     string
+	Reason   string
+}
+
+// RoleActionsFunc returns the actions granted by a role on a service.
+type RoleActionsFunc func(service, role string) ([]string, error)
+
+// SimulateAccess evaluates the policies in order and allows the access with
+// the first policy that applies to one of the subjects, matches the resource
+// and has a role that grants the action. The account of the resource is not
+// evaluated; a resource without a serviceType attribute is treated as an IAM
+// enabled service.
+func SimulateAccess(policies []SimulatedPolicy, roleActions RoleActionsFunc, access SimulatedAccess) (SimulatedDecision, error) {
+	attributes := make(map[string]string, len(access.ResourceAttributes)+1)
+	for k, v := range access.ResourceAttributes {
+		attributes[k] = v
+	}
+	if _, ok := attributes["serviceType"]; !ok {
+		attributes["serviceType"] = "service"
+	}
+
+	decision := SimulatedDecision{
+		Decision: SimulatedDeny,
+		Reason:   "no policy applies to the subject",
+	}
+	for _, policy := range policies {
+		if !simulatedSubjectMatches(policy.Subjects, access.Subjects) {
+			continue
+		}
+		if !simulatedResourceMatches(policy.Resources, attributes, access.ResourceTags) {
+			decision.Reason = "no policy of the subject matches the resource"
+			continue
+		}
+		for _, role := range policy.Roles {
+			actions, err := roleActions(attributes["serviceName"], role)
+			if err != nil {
+				return SimulatedDecision{}, err
+			}
+			for _, action := range actions {
+				if action == access.Action {
+					return SimulatedDecision{
+						Decision: SimulatedAllow,
+						PolicyID: policy.ID,
+						Role:     role,
+						Reason:   fmt.Sprintf("role %s of policy %s grants %s", role, policy.ID, access.Action),
+					}, nil
+				}
+			}
+		}
+		decision.Reason = fmt.Sprintf("no role of the matching policies grants %s", access.Action)
+	}
+	return decision, nil
+}
+
+func simulatedSubjectMatches(policySubjects, subjects []string) bool {
+	if len(policySubjects) == 0 {
+		return true
+	}
+	for _, ps := range policySubjects {
+		for _, s := range subjects {
+			if ps == s {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// simulatedResourceMatches reports whether every attribute and tag of one of
+// the policy resources is satisfied by the resource.
+func simulatedResourceMatches(resources []iampolicymanagementv1.PolicyResource, attributes, tags map[string]string) bool {
+	for _, r := range resources {
+		matches := true
+		for _, a := range r.Attributes {
+			if a.Name == nil || *a.Name == "accountId" {
+				continue
+			}
+			value, ok := attributes[*a.Name]
+			if !simulatedValueMatches(a.Operator, a.Value, value, ok) {
+				matches = false
+				break
+			}
+		}
+		for _, t := range r.Tags {
+			if !matches {
+				break
+			}
+			if t.Name == nil {
+				continue
+			}
+			value, ok := tags[*t.Name]
+			if !simulatedValueMatches(t.Operator, t.Value, value, ok) {
+				matches = false
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func simulatedValueMatches(operator, expected *string, value string, ok bool) bool {
+	op := "stringEquals"
+	if operator != nil && *operator != "" {
+		op = *operator
+	}
+	want := ""
+	if expected != nil {
+		want = *expected
+	}
+
+	switch op {
+	case "stringEquals":
+		return ok && value == want
+	case "stringMatch":
+		return ok && simulatedWildcard(want).MatchString(value)
+	case "stringExists":
+		return ok == (want == "true")
+	}
+	return false
+}
+
+// simulatedWildcard compiles a stringMatch pattern, where * matches any
+// characters and ? matches one character.
+func simulatedWildcard(pattern string) *regexp.Regexp {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	return regexp.MustCompile("^" + expr + "$")
+}
@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+func testSimulatedRoleActions(service, role string) ([]string, error) {
+	actions := map[string][]string{
+		"kms/Reader":  {"kms.secrets.list"},
+		"kms/Manager": {"kms.secrets.list", "kms.secrets.create"},
+		"cos/Writer":  {"cloud-object-storage.object.put"},
+		"kms/Viewer":  {"resource-controller.instance.retrieve"},
+		"cos/Viewer":  {"resource-controller.instance.retrieve"},
+	}
+	return actions[service+"/"+role], nil
+}
+
+func testSimulatedResource(attributes map[string]string, operators map[string]string) []iampolicymanagementv1.PolicyResource {
+	r := iampolicymanagementv1.PolicyResource{}
+	for name, value := range attributes {
+		a := iampolicymanagementv1.ResourceAttribute{Name: core.StringPtr(name), Value: core.StringPtr(value)}
+		if op, ok := operators[name]; ok {
+			a.Operator = core.StringPtr(op)
+		}
+		r.Attributes = append(r.Attributes, a)
+	}
+	return []iampolicymanagementv1.PolicyResource{r}
+}
+
+func TestSimulateAccess(t *testing.T) {
+	policies := []SimulatedPolicy{
+		{
+			ID:        "kms-readers",
+			Subjects:  []string{"AccessGroupId-readers"},
+			Roles:     []string{"Viewer", "Reader"},
+			Resources: testSimulatedResource(map[string]string{"accountId": "acc", "serviceName": "kms", "region": "us-south"}, nil),
+		},
+		{
+			ID:        "kms-managers",
+			Subjects:  []string{"IBMid-manager"},
+			Roles:     []string{"Manager"},
+			Resources: testSimulatedResource(map[string]string{"serviceName": "kms", "serviceInstance": "instance-*"}, map[string]string{"serviceInstance": "stringMatch"}),
+		},
+		{
+			ID:       "all-services-viewer",
+			Subjects: []string{"AccessGroupId-viewers"},
+			Roles:    []string{"Viewer"},
+			Resources: []iampolicymanagementv1.PolicyResource{
+				{
+					Attributes: []iampolicymanagementv1.ResourceAttribute{{Name: core.StringPtr("serviceType"), Value: core.StringPtr("service")}},
+					Tags:       []iampolicymanagementv1.ResourceTag{{Name: core.StringPtr("env"), Value: core.StringPtr("dev"), Operator: core.StringPtr("stringEquals")}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		access   SimulatedAccess
+		decision string
+		policyID string
+		role     string
+		reason   string
+	}{
+		{
+			name: "allowed by access group",
+			access: SimulatedAccess{
+				Subjects:           []string{"IBMid-reader", "AccessGroupId-readers"},
+				Action:             "kms.secrets.list",
+				ResourceAttributes: map[string]string{"serviceName": "kms", "region": "us-south"},
+			},
+			decision: SimulatedAllow,
+			policyID: "kms-readers",
+			role:     "Reader",
+		},
+		{
+			name: "action not granted",
+			access: SimulatedAccess{
+				Subjects:           []string{"AccessGroupId-readers"},
+				Action:             "kms.secrets.create",
+				ResourceAttributes: map[string]string{"serviceName": "kms", "region": "us-south"},
+			},
+			decision: SimulatedDeny,
+			reason:   "no role of the matching policies grants kms.secrets.create",
+		},
+		{
+			name: "resource in another region",
+			access: SimulatedAccess{
+				Subjects:           []string{"AccessGroupId-readers"},
+				Action:             "kms.secrets.list",
+				ResourceAttributes: map[string]string{"serviceName": "kms", "region": "eu-de"},
+			},
+			decision: SimulatedDeny,
+			reason:   "no policy of the subject matches the resource",
+		},
+		{
+			name: "wildcard instance",
+			access: SimulatedAccess{
+				Subjects:           []string{"IBMid-manager"},
+				Action:             "kms.secrets.create",
+				ResourceAttributes: map[string]string{"serviceName": "kms", "serviceInstance": "instance-42"},
+			},
+			decision: SimulatedAllow,
+			policyID: "kms-managers",
+			role:     "Manager",
+		},
+		{
+			name: "unknown subject",
+			access: SimulatedAccess{
+				Subjects:           []string{"IBMid-other"},
+				Action:             "kms.secrets.list",
+				ResourceAttributes: map[string]string{"serviceName": "kms", "region": "us-south"},
+			},
+			decision: SimulatedDeny,
+			reason:   "no policy applies to the subject",
+		},
+		{
+			name: "tagged resource of any service",
+			access: SimulatedAccess{
+				Subjects:           []string{"AccessGroupId-viewers"},
+				Action:             "resource-controller.instance.retrieve",
+				ResourceAttributes: map[string]string{"serviceName": "cos"},
+				ResourceTags:       map[string]string{"env": "dev"},
+			},
+			decision: SimulatedAllow,
+			policyID: "all-services-viewer",
+			role:     "Viewer",
+		},
+		{
+			name: "untagged resource",
+			access: SimulatedAccess{
+				Subjects:           []string{"AccessGroupId-viewers"},
+				Action:             "resource-controller.instance.retrieve",
+				ResourceAttributes: map[string]string{"serviceName": "cos"},
+			},
+			decision: SimulatedDeny,
+			reason:   "no policy of the subject matches the resource",
+		},
+		{
+			name: "account management service",
+			access: SimulatedAccess{
+				Subjects:           []string{"AccessGroupId-viewers"},
+				Action:             "resource-controller.instance.retrieve",
+				ResourceAttributes: map[string]string{"serviceName": "cos", "serviceType": "platform_service"},
+				ResourceTags:       map[string]string{"env": "dev"},
+			},
+			decision: SimulatedDeny,
+			reason:   "no policy of the subject matches the resource",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := SimulateAccess(policies, testSimulatedRoleActions, tc.access)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Decision != tc.decision || got.PolicyID != tc.policyID || got.Role != tc.role {
+				t.Errorf("got %+v, want %s by %q with role %q", got, tc.decision, tc.policyID, tc.role)
+			}
+			if tc.reason != "" && got.Reason != tc.reason {
+				t.Errorf("got reason %q, want %q", got.Reason, tc.reason)
+			}
+		})
+	}
+}
+
+func TestSimulatedValueMatches(t *testing.T) {
+	tests := []struct {
+		operator string
+		expected string
+		value    string
+		ok       bool
+		want     bool
+	}{
+		{"", "kms", "kms", true, true},
+		{"stringEquals", "kms", "KMS", true, false},
+		{"stringMatch", "bucket-?", "bucket-1", true, true},
+		{"stringMatch", "bucket-?", "bucket-10", true, false},
+		{"stringMatch", "a.b*", "axb", true, false},
+		{"stringExists", "true", "", true, true},
+		{"stringExists", "false", "", false, true},
+		{"stringExists", "true", "", false, false},
+		{"timeLessThan", "x", "x", true, false},
+	}
+	for _, tc := range tests {
+		got := simulatedValueMatches(core.StringPtr(tc.operator), core.StringPtr(tc.expected), tc.value, tc.ok)
+		if got != tc.want {
+			t.Errorf("%s %q against %q (present %t): got %t, want %t", tc.operator, tc.expected, tc.value, tc.ok, got, tc.want)
+		}
+	}
+}
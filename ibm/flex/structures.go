@@ -2238,41 +2238,62 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 	return nil
 }
 
-func ResourceLBListenerPolicyCustomizeDiff(diff *schema.ResourceDiff) error {
-	policyActionIntf, _ := diff.GetOk(isLBListenerPolicyAction)
-	policyAction := policyActionIntf.(string)
-
-	if policyAction == "forward" {
-		_, policyTargetIDSet := diff.GetOk(isLBListenerPolicyTargetID)
-
-		if !policyTargetIDSet && diff.NewValueKnown(isLBListenerPolicyTargetID) {
-			return fmt.Errorf("Load balancer listener policy: When action is forward please specify target_id")
-		}
-	} else if policyAction == "redirect" {
-		_, httpsStatusCodeSet := diff.GetOk(isLBListenerPolicyHTTPSRedirectStatusCode)
-		_, targetURLSet := diff.GetOk(isLBListenerPolicyTargetURL)
-
-		if !httpsStatusCodeSet && diff.NewValueKnown(isLBListenerPolicyHTTPSRedirectStatusCode) {
-			return fmt.Errorf("Load balancer listener policy: When action is redirect please specify target_http_status_code")
-		}
+// ResourceDefaultTagsCustomizeDiff merges the provider level default_tags into
+// the tags of the resource, so that plans show the effective tags, and records
+// the effective tags in tags_all. Tags are compared case-insensitively, so a
+// default tag that is also set on the resource does not produce a diff.
+func ResourceDefaultTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	sess, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	if err := mergeDefaultTags(diff, "tags", sess.DefaultTags()); err != nil {
+		return err
+	}
 
-		if !targetURLSet && diff.NewValueKnown(isLBListenerPolicyTargetURL) {
-			return fmt.Errorf("Load balancer listener policy: When action is redirect please specify target_url")
-		}
-	} else if policyAction == "https_redirect" {
-		_, listenerSet := diff.GetOk(isLBListenerPolicyHTTPSRedirectListener)
-		_, httpsStatusSet := diff.GetOk(isLBListenerPolicyHTTPSRedirectStatusCode)
+	if !hasConfigAttribute(diff, "tags_all") {
+		return nil
+	}
+	if !diff.NewValueKnown("tags") || !diff.GetRawConfig().GetAttr("tags").IsWhollyKnown() {
+		return diff.SetNewComputed("tags_all")
+	}
+	return diff.SetNew("tags_all", diff.Get("tags"))
+}
 
-		if !listenerSet && diff.NewValueKnown(isLBListenerPolicyHTTPSRedirectListener) {
-			return fmt.Errorf("Load balancer listener policy: When action is https_redirect please specify target_https_redirect_listener")
-		}
+// ResourceDefaultAccessTagsCustomizeDiff merges the provider level
+// default_access_tags into the access_tags of the resource
+func ResourceDefaultAccessTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	sess, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	return mergeDefaultTags(diff, "access_tags", sess.DefaultAccessTags())
+}
 
-		if !httpsStatusSet && diff.NewValueKnown(isLBListenerPolicyHTTPSRedirectStatusCode) {
-			return fmt.Errorf("When action is https_redirect please specify target_https_redirect_status_code")
+func mergeDefaultTags(diff *schema.ResourceDiff, key string, defaults []string) error {
+	if len(defaults) == 0 || !hasConfigAttribute(diff, key) {
+		return nil
+	}
+	config := diff.GetRawConfig().GetAttr(key)
+	if !config.IsWhollyKnown() {
+		return nil
+	}
+	tags := make([]string, 0, len(defaults))
+	if !config.IsNull() {
+		for it := config.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			if !v.IsNull() {
+				tags = append(tags, v.AsString())
+			}
 		}
 	}
+	tags = append(tags, defaults...)
+	return diff.SetNew(key, NewStringSet(ResourceIBMVPCHash, tags))
+}
 
-	return nil
+func hasConfigAttribute(diff *schema.ResourceDiff, key string) bool {
+	config := diff.GetRawConfig()
+	return !config.IsNull() && config.Type().IsObjectType() && config.Type().HasAttribute(key)
 }
 
 func ResourceIBMISLBPoolCookieValidate(diff *schema.ResourceDiff) error {
@@ -2878,10 +2899,102 @@ func MapRoleListToPolicyRoles(roleList iampolicymanagementv1.RoleList) []iampoli
 	return policyRoles
 }
 
-func GeneratePolicyOptions(d *schema.ResourceData, meta interface{}) (iampolicymanagementv1.CreatePolicyOptions, error) {
+// PolicyData is the view of a policy definition read by GeneratePolicyOptions
+// and SetTags. *schema.ResourceData satisfies it for resources that manage a
+// single policy; PolicyMap adapts one policy block of a resource that manages
+// several.
+type PolicyData interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+// PolicyMap is one policy block, as returned by d.Get, viewed as PolicyData.
+type PolicyMap map[string]interface{}
+
+func (m PolicyMap) Get(key string) interface{} {
+	return m[key]
+}
+
+// GetOk mirrors schema.ResourceData.GetOk: ok is false for zero values.
+func (m PolicyMap) GetOk(key string) (interface{}, bool) {
+	v, ok := m[key]
+	if !ok || v == nil {
+		return v, false
+	}
+	switch t := v.(type) {
+	case string:
+		return v, t != ""
+	case bool:
+		return v, t
+	case int:
+		return v, t != 0
+	case []interface{}:
+		return v, len(t) > 0
+	case map[string]interface{}:
+		return v, len(t) > 0
+	case *schema.Set:
+		return v, t.Len() > 0
+	}
+	return v, true
+}
+
+// GeneratePolicyResourceAttributes returns the resource attributes of the
+// policy definition, without the accountId attribute added on create.
+func GeneratePolicyResourceAttributes(d PolicyData) []iampolicymanagementv1.ResourceAttribute {
+	resourceAttributes, _ := generatePolicyResourceAttributes(d)
+	return resourceAttributes
+}
+
+func GeneratePolicyOptions(d PolicyData, meta interface{}) (iampolicymanagementv1.CreatePolicyOptions, error) {
 
-	var serviceName string
 	var resourceType string
+	resourceAttributes, serviceName := generatePolicyResourceAttributes(d)
+
+	policyResources := iampolicymanagementv1.PolicyResource{
+		Attributes: resourceAttributes,
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return iampolicymanagementv1.CreatePolicyOptions{}, err
+	}
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+
+	if err != nil {
+		return iampolicymanagementv1.CreatePolicyOptions{}, err
+	}
+
+	serviceToQuery := serviceName
+
+	if serviceName == "" && // no specific service specified
+		!d.Get("account_management").(bool) && // not all account management services
+		resourceType != "resource-group" { // not to a resource group
+		serviceToQuery = "alliamserviceroles"
+	}
+
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   &userDetails.UserAccount,
+		ServiceName: &serviceToQuery,
+	}
+
+	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil {
+		return iampolicymanagementv1.CreatePolicyOptions{}, err
+	}
+
+	roles := MapRoleListToPolicyRoles(*roleList)
+	policyRoles, err := GetRolesFromRoleNames(ExpandStringList(d.Get("roles").([]interface{})), roles)
+	if err != nil {
+		return iampolicymanagementv1.CreatePolicyOptions{}, err
+	}
+
+	return iampolicymanagementv1.CreatePolicyOptions{Roles: policyRoles, Resources: []iampolicymanagementv1.PolicyResource{policyResources}}, nil
+}
+
+func generatePolicyResourceAttributes(d PolicyData) ([]iampolicymanagementv1.ResourceAttribute, string) {
+
+	var serviceName string
 	resourceAttributes := []iampolicymanagementv1.ResourceAttribute{}
 
 	if res, ok := d.GetOk("resources"); ok {
@@ -3012,49 +3125,10 @@ func GeneratePolicyOptions(d *schema.ResourceData, meta interface{}) (iampolicym
 		resourceAttributes = append(resourceAttributes, serviceTypeResourceAttribute)
 	}
 
-	policyResources := iampolicymanagementv1.PolicyResource{
-		Attributes: resourceAttributes,
-	}
-
-	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
-	if err != nil {
-		return iampolicymanagementv1.CreatePolicyOptions{}, err
-	}
-
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
-
-	if err != nil {
-		return iampolicymanagementv1.CreatePolicyOptions{}, err
-	}
-
-	serviceToQuery := serviceName
-
-	if serviceName == "" && // no specific service specified
-		!d.Get("account_management").(bool) && // not all account management services
-		resourceType != "resource-group" { // not to a resource group
-		serviceToQuery = "alliamserviceroles"
-	}
-
-	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
-		AccountID:   &userDetails.UserAccount,
-		ServiceName: &serviceToQuery,
-	}
-
-	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
-	if err != nil {
-		return iampolicymanagementv1.CreatePolicyOptions{}, err
-	}
-
-	roles := MapRoleListToPolicyRoles(*roleList)
-	policyRoles, err := GetRolesFromRoleNames(ExpandStringList(d.Get("roles").([]interface{})), roles)
-	if err != nil {
-		return iampolicymanagementv1.CreatePolicyOptions{}, err
-	}
-
-	return iampolicymanagementv1.CreatePolicyOptions{Roles: policyRoles, Resources: []iampolicymanagementv1.PolicyResource{policyResources}}, nil
+	return resourceAttributes, serviceName
 }
 
-func SetTags(d *schema.ResourceData) []iampolicymanagementv1.ResourceTag {
+func SetTags(d PolicyData) []iampolicymanagementv1.ResourceTag {
 	resourceAttributes := []iampolicymanagementv1.ResourceTag{}
 	if r, ok := d.GetOk("resource_tags"); ok {
 		for _, attribute := range r.(*schema.Set).List() {
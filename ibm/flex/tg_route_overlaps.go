@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+)
+
+// TgRoute is a prefix a transit gateway connection advertises to the gateway
+type TgRoute struct {
+	ConnectionID   string
+	ConnectionName string
+	ConnectionType string
+	Prefix         string
+}
+
+// TgRouteOverlap is a pair of overlapping routes of two connections of a
+// transit gateway. Conflict is set when both connections advertise the same
+// prefix, in which case only one of them is used by the gateway.
+type TgRouteOverlap struct {
+	Route       TgRoute
+	Overlapping TgRoute
+	Conflict    bool
+}
+
+// NewTgRoutes returns the routes of the connections of a route report. The
+// used routes and the BGP prefixes of a connection are merged, so a prefix that
+// loses the BGP selection to another connection is still returned.
+func NewTgRoutes(report *transitgatewayapisv1.RouteReport) []TgRoute {
+	routes := []TgRoute{}
+	for _, connection := range report.Connections {
+		prefixes := []string{}
+		for _, route := range connection.Routes {
+			prefixes = append(prefixes, stringValue(route.Prefix))
+		}
+		for _, bgp := range connection.Bgps {
+			prefixes = append(prefixes, stringValue(bgp.Prefix))
+		}
+
+		seen := map[string]bool{}
+		for _, prefix := range prefixes {
+			if prefix == "" || seen[prefix] {
+				continue
+			}
+			seen[prefix] = true
+			routes = append(routes, TgRoute{
+				ConnectionID:   stringValue(connection.ID),
+				ConnectionName: stringValue(connection.Name),
+				ConnectionType: stringValue(connection.Type),
+				Prefix:         prefix,
+			})
+		}
+	}
+	return routes
+}
+
+// TgRouteOverlaps returns every pair of routes of different connections whose
+// prefixes overlap, in order of the routes
+func TgRouteOverlaps(routes []TgRoute) ([]TgRouteOverlap, error) {
+	networks, err := parseTgRoutes(routes)
+	if err != nil {
+		return nil, err
+	}
+
+	overlaps := []TgRouteOverlap{}
+	for i := range routes {
+		for j := i + 1; j < len(routes); j++ {
+			if routes[i].ConnectionID == routes[j].ConnectionID || !tgNetworksOverlap(networks[i], networks[j]) {
+				continue
+			}
+			overlaps = append(overlaps, TgRouteOverlap{
+				Route:       routes[i],
+				Overlapping: routes[j],
+				Conflict:    networks[i].String() == networks[j].String(),
+			})
+		}
+	}
+	return overlaps, nil
+}
+
+// TgOverlappingRoutes returns the routes of connections other than
+// connectionID whose prefixes overlap prefix
+func TgOverlappingRoutes(prefix string, routes []TgRoute, connectionID string) ([]TgRoute, error) {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid prefix %s: %s", prefix, err)
+	}
+	networks, err := parseTgRoutes(routes)
+	if err != nil {
+		return nil, err
+	}
+
+	overlapping := []TgRoute{}
+	for i, route := range routes {
+		if route.ConnectionID != connectionID && tgNetworksOverlap(network, networks[i]) {
+			overlapping = append(overlapping, route)
+		}
+	}
+	return overlapping, nil
+}
+
+func parseTgRoutes(routes []TgRoute) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(routes))
+	for _, route := range routes {
+		_, network, err := net.ParseCIDR(route.Prefix)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Invalid prefix %s of connection %s: %s", route.Prefix, route.ConnectionID, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// tgNetworksOverlap reports whether two networks share an address, which is
+// the case when either contains the first address of the other
+func tgNetworksOverlap(a, b *net.IPNet) bool {
+	if (a.IP.To4() == nil) != (b.IP.To4() == nil) {
+		return false
+	}
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
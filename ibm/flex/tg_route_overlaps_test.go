@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+)
+
+func TestNewTgRoutes(t *testing.T) {
+	routes := NewTgRoutes(&transitgatewayapisv1.RouteReport{
+		Connections: []transitgatewayapisv1.RouteReportConnection{
+			{
+				ID:   core.StringPtr("vpc-1"),
+				Name: core.StringPtr("app"),
+				Type: core.StringPtr("vpc"),
+				Routes: []transitgatewayapisv1.RouteReportConnectionRoute{
+					{Prefix: core.StringPtr("10.240.0.0/24")},
+				},
+			},
+			{
+				ID:   core.StringPtr("dl-1"),
+				Name: core.StringPtr("onprem"),
+				Type: core.StringPtr("directlink"),
+				Routes: []transitgatewayapisv1.RouteReportConnectionRoute{
+					{Prefix: core.StringPtr("192.168.0.0/16")},
+				},
+				Bgps: []transitgatewayapisv1.RouteReportConnectionBgp{
+					{Prefix: core.StringPtr("192.168.0.0/16"), IsUsed: core.BoolPtr(true)},
+					{Prefix: core.StringPtr("10.240.0.0/24"), IsUsed: core.BoolPtr(false)},
+				},
+			},
+		},
+	})
+
+	want := []TgRoute{
+		{ConnectionID: "vpc-1", ConnectionName: "app", ConnectionType: "vpc", Prefix: "10.240.0.0/24"},
+		{ConnectionID: "dl-1", ConnectionName: "onprem", ConnectionType: "directlink", Prefix: "192.168.0.0/16"},
+		{ConnectionID: "dl-1", ConnectionName: "onprem", ConnectionType: "directlink", Prefix: "10.240.0.0/24"},
+	}
+	if !reflect.DeepEqual(routes, want) {
+		t.Errorf("expected %+v, got %+v", want, routes)
+	}
+}
+
+func TestTgRouteOverlaps(t *testing.T) {
+	routes := []TgRoute{
+		{ConnectionID: "vpc-1", ConnectionType: "vpc", Prefix: "10.240.0.0/24"},
+		{ConnectionID: "vpc-1", ConnectionType: "vpc", Prefix: "10.240.64.0/24"},
+		{ConnectionID: "classic", ConnectionType: "classic", Prefix: "10.0.0.0/8"},
+		{ConnectionID: "gre-1", ConnectionType: "gre_tunnel", Prefix: "10.240.0.0/24"},
+		{ConnectionID: "dl-1", ConnectionType: "directlink", Prefix: "172.16.0.0/12"},
+		{ConnectionID: "dl-2", ConnectionType: "directlink", Prefix: "fd00::/8"},
+	}
+
+	overlaps, err := TgRouteOverlaps(routes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []TgRouteOverlap{
+		{Route: routes[0], Overlapping: routes[2]},
+		{Route: routes[0], Overlapping: routes[3], Conflict: true},
+		{Route: routes[1], Overlapping: routes[2]},
+		{Route: routes[2], Overlapping: routes[3]},
+	}
+	if !reflect.DeepEqual(overlaps, want) {
+		t.Errorf("expected %+v, got %+v", want, overlaps)
+	}
+
+	if _, err := TgRouteOverlaps([]TgRoute{{ConnectionID: "vpc-1", Prefix: "10.240.0.0"}}); err == nil {
+		t.Error("expected an error for a prefix that is not a CIDR")
+	}
+}
+
+func TestTgOverlappingRoutes(t *testing.T) {
+	routes := []TgRoute{
+		{ConnectionID: "vpc-1", Prefix: "10.240.0.0/24"},
+		{ConnectionID: "vpc-2", Prefix: "10.240.0.0/18"},
+		{ConnectionID: "dl-1", Prefix: "192.168.0.0/16"},
+	}
+
+	tests := []struct {
+		name         string
+		prefix       string
+		connectionID string
+		want         []TgRoute
+	}{
+		{
+			name:   "contained prefix",
+			prefix: "10.240.0.128/25",
+			want:   []TgRoute{routes[0], routes[1]},
+		},
+		{
+			name:         "routes of the connection are ignored",
+			prefix:       "10.240.0.0/16",
+			connectionID: "vpc-2",
+			want:         []TgRoute{routes[0]},
+		},
+		{
+			name:   "disjoint prefix",
+			prefix: "172.16.0.0/12",
+			want:   []TgRoute{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overlapping, err := TgOverlappingRoutes(tt.prefix, routes, tt.connectionID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(overlapping, tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, overlapping)
+			}
+		})
+	}
+
+	if _, err := TgOverlappingRoutes("10.240.0.0", routes, ""); err == nil {
+		t.Error("expected an error for a prefix that is not a CIDR")
+	}
+}
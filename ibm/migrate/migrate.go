@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package migrate holds the helpers shared by the versioned state upgrades of
+// the resources.
+//
+// A resource whose schema changes in a way that existing state cannot be read
+// with, e.g. an attribute is renamed, bumps its schema version with Versioned
+// and registers one Upgrade per version. Each Upgrade works on the raw state
+// decoded from JSON, so it can be unit tested with Apply without any API call.
+package migrate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Upgrade upgrades the state of a resource from Version to Version+1
+type Upgrade struct {
+	// Version is the schema version of the state the upgrade applies to
+	Version int
+
+	// Schema is the schema of the resource at Version. Terraform only uses it to
+	// decode state stored in the legacy flatmap format. When nil, the current
+	// schema is used, which is only correct as long as the upgrades that follow
+	// add attributes or change values, and do not remove or retype attributes.
+	Schema map[string]*schema.Schema
+
+	// Upgrade rewrites the raw state and returns it
+	Upgrade schema.StateUpgradeFunc
+}
+
+// Versioned sets the schema version of the resource and registers its state
+// upgrades. The upgrades must be ordered and consecutive, starting at 0, and
+// the schema version is set to the version that follows the last one.
+func Versioned(r *schema.Resource, upgrades ...Upgrade) *schema.Resource {
+	r.StateUpgraders = make([]schema.StateUpgrader, 0, len(upgrades))
+	for _, u := range upgrades {
+		s := u.Schema
+		if s == nil {
+			s = r.Schema
+		}
+		r.StateUpgraders = append(r.StateUpgraders, schema.StateUpgrader{
+			Version: u.Version,
+			Type:    (&schema.Resource{Schema: s}).CoreConfigSchema().ImpliedType(),
+			Upgrade: u.Upgrade,
+		})
+		r.SchemaVersion = u.Version + 1
+	}
+	return r
+}
+
+// Apply runs the state upgrades of the resource on rawState, stored at the
+// given schema version, the same way Terraform does
+func Apply(ctx context.Context, r *schema.Resource, version int, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if version > r.SchemaVersion {
+		return nil, fmt.Errorf("[ERROR] State schema version %d is newer than the resource schema version %d", version, r.SchemaVersion)
+	}
+	var err error
+	for _, u := range r.StateUpgraders {
+		if u.Version != version {
+			continue
+		}
+		rawState, err = u.Upgrade(ctx, rawState, meta)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error upgrading state from schema version %d: %s", version, err)
+		}
+		version++
+	}
+	return rawState, nil
+}
+
+// IsEmpty reports whether the raw state value is null or the zero value of
+// its attribute
+func IsEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// Rename moves the value of the attribute from to the attribute to, unless to
+// is already set, and removes from
+func Rename(rawState map[string]interface{}, from, to string) {
+	if rawState == nil {
+		return
+	}
+	if v, ok := rawState[from]; ok {
+		if IsEmpty(rawState[to]) {
+			rawState[to] = v
+		}
+		delete(rawState, from)
+	}
+}
+
+// Remove removes the attributes from the raw state
+func Remove(rawState map[string]interface{}, attributes ...string) {
+	for _, a := range attributes {
+		delete(rawState, a)
+	}
+}
+
+// SetDefault sets the attribute to value when it is missing or null
+func SetDefault(rawState map[string]interface{}, attribute string, value interface{}) {
+	if rawState == nil {
+		return
+	}
+	if v, ok := rawState[attribute]; !ok || v == nil {
+		rawState[attribute] = value
+	}
+}
+
+// Blocks returns the objects of a nested block of the raw state, e.g. the
+// elements of a TypeList or TypeSet with a schema.Resource Elem. Changes to
+// the returned maps are made to the raw state.
+func Blocks(rawState map[string]interface{}, attribute string) []map[string]interface{} {
+	list, _ := rawState[attribute].([]interface{})
+	blocks := make([]map[string]interface{}, 0, len(list))
+	for _, v := range list {
+		if block, ok := v.(map[string]interface{}); ok {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testResource() *schema.Resource {
+	return Versioned(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":  {Type: schema.TypeString, Optional: true},
+			"label": {Type: schema.TypeString, Optional: true},
+			"size":  {Type: schema.TypeInt, Optional: true},
+		},
+	}, Upgrade{
+		Version: 0,
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			Rename(rawState, "title", "label")
+			return rawState, nil
+		},
+	}, Upgrade{
+		Version: 1,
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			if rawState["name"] == "invalid" {
+				return nil, errors.New("invalid name")
+			}
+			SetDefault(rawState, "size", float64(1))
+			return rawState, nil
+		},
+	})
+}
+
+func TestVersioned(t *testing.T) {
+	r := testResource()
+	if r.SchemaVersion != 2 || len(r.StateUpgraders) != 2 {
+		t.Fatalf("expected schema version 2 with 2 upgraders, got %d with %d", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestApply(t *testing.T) {
+	r := testResource()
+	cases := []struct {
+		version int
+		state   map[string]interface{}
+		want    map[string]interface{}
+	}{
+		{0, map[string]interface{}{"name": "a", "title": "b"}, map[string]interface{}{"name": "a", "label": "b", "size": float64(1)}},
+		{0, map[string]interface{}{"title": "b", "label": "c", "size": float64(3)}, map[string]interface{}{"label": "c", "size": float64(3)}},
+		{1, map[string]interface{}{"title": "b"}, map[string]interface{}{"title": "b", "size": float64(1)}},
+		{2, map[string]interface{}{"title": "b"}, map[string]interface{}{"title": "b"}},
+	}
+	for _, c := range cases {
+		got, err := Apply(context.Background(), r, c.version, c.state, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("version %d: expected %v, got %v", c.version, c.want, got)
+		}
+	}
+
+	if _, err := Apply(context.Background(), r, 0, map[string]interface{}{"name": "invalid"}, nil); err == nil {
+		t.Error("expected the error of the upgrade")
+	}
+	if _, err := Apply(context.Background(), r, 3, map[string]interface{}{}, nil); err == nil {
+		t.Error("expected an error for a state newer than the resource")
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	rawState := map[string]interface{}{
+		"nic":  []interface{}{map[string]interface{}{"address": "10.0.0.1"}},
+		"name": "a",
+	}
+	for _, nic := range Blocks(rawState, "nic") {
+		Remove(nic, "address")
+	}
+	if !reflect.DeepEqual(rawState["nic"], []interface{}{map[string]interface{}{}}) {
+		t.Errorf("expected the blocks to be changed in place, got %v", rawState["nic"])
+	}
+	if blocks := Blocks(rawState, "name"); len(blocks) != 0 {
+		t.Errorf("expected no block for an attribute, got %v", blocks)
+	}
+	if blocks := Blocks(rawState, "missing"); len(blocks) != 0 {
+		t.Errorf("expected no block for a missing attribute, got %v", blocks)
+	}
+}
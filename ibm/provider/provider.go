@@ -4,13 +4,16 @@
 package provider
 
 import (
+	"fmt"
 	"os"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -160,6 +163,32 @@ func Provider() *schema.Provider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"retry_base_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The wait (in seconds) before the first retry of a failed API call. The wait doubles with every retry up to retry_max_delay.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_RETRY_BASE_DELAY", "IBMCLOUD_RETRY_BASE_DELAY"}, 1),
+			},
+			"retry_max_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The longest wait (in seconds) between two retries of a failed API call, unless the API asks for a longer one with a Retry-After header.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_RETRY_MAX_DELAY", "IBMCLOUD_RETRY_MAX_DELAY"}, 30),
+			},
+			"retry_jitter": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Randomize the wait between retries of failed API calls, so that calls that failed together are not retried together.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_RETRY_JITTER", "IBMCLOUD_RETRY_JITTER"}, true),
+			},
+			"retryable_status_codes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(400, 599)},
+				Description: "The HTTP status codes of the API calls that are retried. Defaults to 408, 429, 500, 502, 503, 504, 520 and 599.",
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -212,6 +241,34 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"default_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "User tags that are added to the tags of every taggable resource managed by the provider",
+			},
+			"default_access_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "Access management tags that are added to the access_tags of the ibm_is_placement_group and ibm_is_subnet resources",
+			},
+			"capacity_checks": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{conns.CapacityChecksOff, conns.CapacityChecksError}),
+				Description:  "Check the quotas and the available capacity at plan time for the resources that support it: off, or error to fail the plan when a check fails",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_CAPACITY_CHECKS", "IBMCLOUD_CAPACITY_CHECKS"}, conns.CapacityChecksOff),
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        &schema.Resource{Schema: endpointsSchema()},
+				Description: "Custom service endpoints, which take precedence over the endpoint environment variables and the endpoints file",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -295,6 +352,7 @@ func Provider() *schema.Provider {
 			"ibm_database_tasks":                    database.DataSourceIBMDatabaseTasks(),
 			"ibm_database_backup":                   database.DataSourceIBMDatabaseBackup(),
 			"ibm_database_backups":                  database.DataSourceIBMDatabaseBackups(),
+			"ibm_database_latest_backup":            database.DataSourceIBMDatabaseLatestBackup(),
 			"ibm_compute_bare_metal":                classicinfrastructure.DataSourceIBMComputeBareMetal(),
 			"ibm_compute_image_template":            classicinfrastructure.DataSourceIBMComputeImageTemplate(),
 			"ibm_compute_placement_group":           classicinfrastructure.DataSourceIBMComputePlacementGroup(),
@@ -340,6 +398,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_access_group_policy":           iampolicy.DataSourceIBMIAMAccessGroupPolicy(),
 			"ibm_iam_account_settings":              iamidentity.DataSourceIBMIAMAccountSettings(),
 			"ibm_iam_auth_token":                    iamidentity.DataSourceIBMIAMAuthToken(),
+			"ibm_iam_policy_simulation":             iampolicy.DataSourceIBMIAMPolicySimulation(),
 			"ibm_iam_role_actions":                  iampolicy.DataSourceIBMIAMRoleAction(),
 			"ibm_iam_users":                         iamidentity.DataSourceIBMIAMUsers(),
 			"ibm_iam_roles":                         iampolicy.DataSourceIBMIAMRole(),
@@ -547,6 +606,8 @@ func Provider() *schema.Provider {
 			"ibm_pi_network_port":           power.DataSourceIBMPINetworkPort(),
 			"ibm_pi_placement_group":        power.DataSourceIBMPIPlacementGroup(),
 			"ibm_pi_placement_groups":       power.DataSourceIBMPIPlacementGroups(),
+			"ibm_pi_shared_processor_pool":  power.DataSourceIBMPISharedProcessorPool(),
+			"ibm_pi_shared_processor_pools": power.DataSourceIBMPISharedProcessorPools(),
 			"ibm_pi_public_network":         power.DataSourceIBMPIPublicNetwork(),
 			"ibm_pi_pvm_snapshots":          power.DataSourceIBMPISnapshot(),
 			"ibm_pi_sap_profile":            power.DataSourceIBMPISAPProfile(),
@@ -592,6 +653,7 @@ func Provider() *schema.Provider {
 			"ibm_tg_location":                  transitgateway.DataSourceIBMTransitGatewaysLocation(),
 			"ibm_tg_route_report":              transitgateway.DataSourceIBMTransitGatewayRouteReport(),
 			"ibm_tg_route_reports":             transitgateway.DataSourceIBMTransitGatewayRouteReports(),
+			"ibm_tg_route_report_overlaps":     transitgateway.DataSourceIBMTransitGatewayRouteReportOverlaps(),
 
 			// //Added for BSS Enterprise
 			"ibm_enterprises":               enterprise.DataSourceIBMEnterprises(),
@@ -647,8 +709,9 @@ func Provider() *schema.Provider {
 			"ibm_scc_posture_credentials":       scc.DataSourceIBMSccPostureCredentials(),
 			"ibm_scc_posture_collectors":        scc.DataSourceIBMSccPostureCollectors(),
 			// // Added for Context Based Restrictions
-			"ibm_cbr_zone": contextbasedrestrictions.DataSourceIBMCbrZone(),
-			"ibm_cbr_rule": contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_zone":        contextbasedrestrictions.DataSourceIBMCbrZone(),
+			"ibm_cbr_rule":        contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_rule_impact": contextbasedrestrictions.DataSourceIBMCbrRuleImpact(),
 
 			// // Added for Event Notifications
 			"ibm_en_source":               eventnotification.DataSourceIBMEnSource(),
@@ -747,6 +810,10 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                    functions.ResourceIBMFunctionNamespace(),
 			"ibm_cis":                                   cis.ResourceIBMCISInstance(),
 			"ibm_database":                              database.ResourceIBMDatabaseInstance(),
+			"ibm_database_allowlist":                    database.ResourceIBMDatabaseAllowlist(),
+			"ibm_database_backup":                       database.ResourceIBMDatabaseBackup(),
+			"ibm_database_configuration":                database.ResourceIBMDatabaseConfiguration(),
+			"ibm_database_user":                         database.ResourceIBMDatabaseUser(),
 			"ibm_certificate_manager_import":            certificatemanager.ResourceIBMCertificateManagerImport(),
 			"ibm_certificate_manager_order":             certificatemanager.ResourceIBMCertificateManagerOrder(),
 			"ibm_cis_domain":                            cis.ResourceIBMCISDomain(),
@@ -819,6 +886,10 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket":                            cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":           cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                     cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_directory_sync":             cos.ResourceIBMCOSBucketDirectorySync(),
+			"ibm_cos_bucket_website_configuration":      cos.ResourceIBMCOSBucketWebsiteConfiguration(),
+			"ibm_cos_bucket_cors":                       cos.ResourceIBMCOSBucketCors(),
+			"ibm_cos_bucket_public_access_block":        cos.ResourceIBMCOSBucketPublicAccessBlock(),
 			"ibm_dns_domain":                            classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":   classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                         classicinfrastructure.ResourceIBMDNSSecondary(),
@@ -839,6 +910,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_access_group_dynamic_rule":         iamaccessgroup.ResourceIBMIAMDynamicRule(),
 			"ibm_iam_access_group_members":              iamaccessgroup.ResourceIBMIAMAccessGroupMembers(),
 			"ibm_iam_access_group_policy":               iampolicy.ResourceIBMIAMAccessGroupPolicy(),
+			"ibm_iam_access_group_policies":             iampolicy.ResourceIBMIAMAccessGroupPolicies(),
 			"ibm_iam_authorization_policy":              iampolicy.ResourceIBMIAMAuthorizationPolicy(),
 			"ibm_iam_authorization_policy_detach":       iampolicy.ResourceIBMIAMAuthorizationPolicyDetach(),
 			"ibm_iam_user_policy":                       iampolicy.ResourceIBMIAMUserPolicy(),
@@ -971,11 +1043,14 @@ func Provider() *schema.Provider {
 			"ibm_pi_instance":                        power.ResourceIBMPIInstance(),
 			"ibm_pi_operations":                      power.ResourceIBMPIIOperations(),
 			"ibm_pi_volume_attach":                   power.ResourceIBMPIVolumeAttach(),
+			"ibm_pi_volume_group":                    power.ResourceIBMPIVolumeGroup(),
+			"ibm_pi_volume_group_action":             power.ResourceIBMPIVolumeGroupAction(),
 			"ibm_pi_capture":                         power.ResourceIBMPICapture(),
 			"ibm_pi_image":                           power.ResourceIBMPIImage(),
 			"ibm_pi_image_export":                    power.ResourceIBMPIImageExport(),
 			"ibm_pi_network_port":                    power.ResourceIBMPINetworkPort(),
 			"ibm_pi_snapshot":                        power.ResourceIBMPISnapshot(),
+			"ibm_pi_snapshot_restore":                power.ResourceIBMPISnapshotRestore(),
 			"ibm_pi_network_port_attach":             power.ResourceIBMPINetworkPortAttach(),
 			"ibm_pi_dhcp":                            power.ResourceIBMPIDhcp(),
 			"ibm_pi_cloud_connection":                power.ResourceIBMPICloudConnection(),
@@ -985,6 +1060,8 @@ func Provider() *schema.Provider {
 			"ibm_pi_vpn_connection":                  power.ResourceIBMPIVPNConnection(),
 			"ibm_pi_console_language":                power.ResourceIBMPIInstanceConsoleLanguage(),
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
+			"ibm_pi_shared_processor_pool":           power.ResourceIBMPISharedProcessorPool(),
+			"ibm_pi_spp_placement_group":             power.ResourceIBMPISPPPlacementGroup(),
 
 			// //Private DNS related resources
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
@@ -1179,6 +1256,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_dl_gateway":                  directlink.ResourceIBMDLGatewayValidator(),
 				"ibm_dl_provider_gateway":         directlink.ResourceIBMDLProviderGatewayValidator(),
 				"ibm_database":                    database.ResourceIBMICDValidator(),
+				"ibm_database_user":               database.ResourceIBMDatabaseUserValidator(),
 				"ibm_function_package":            functions.ResourceIBMFuncPackageValidator(),
 				"ibm_function_action":             functions.ResourceIBMFuncActionValidator(),
 				"ibm_function_rule":               functions.ResourceIBMFuncRuleValidator(),
@@ -1359,6 +1437,21 @@ func Validator() validate.ValidatorDict {
 	return globalValidatorDict
 }
 
+// endpointsSchema returns the attributes of the endpoints block, one for each
+// endpoint that can be overridden with an environment variable
+func endpointsSchema() map[string]*schema.Schema {
+	s := make(map[string]*schema.Schema, len(conns.EndpointEnvs))
+	for k, env := range conns.EndpointEnvs {
+		s[k] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  fmt.Sprintf("The %s endpoint, overrides %s", k, env),
+		}
+	}
+	return s
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
@@ -1420,10 +1513,36 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		file = f.(string)
 	}
 
+	var defaultTags, defaultAccessTags []string
+	if v, ok := d.GetOk("default_tags"); ok {
+		defaultTags = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("default_access_tags"); ok {
+		defaultAccessTags = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+
+	endpoints := map[string]string{}
+	if v, ok := d.GetOk("endpoints"); ok && v.([]interface{})[0] != nil {
+		for k, url := range v.([]interface{})[0].(map[string]interface{}) {
+			if url.(string) != "" {
+				endpoints[k] = url.(string)
+			}
+		}
+	}
+
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	retryBaseDelay := d.Get("retry_base_delay").(int)
+	retryMaxDelay := d.Get("retry_max_delay").(int)
+	retryJitter := d.Get("retry_jitter").(bool)
+	var retryableStatusCodes []int
+	if v, ok := d.GetOk("retryable_status_codes"); ok {
+		for _, code := range v.(*schema.Set).List() {
+			retryableStatusCodes = append(retryableStatusCodes, code.(int))
+		}
+	}
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -1446,7 +1565,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		SoftLayerAPIKey:      softlayerAPIKey,
 		RetryCount:           retryCount,
 		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
+		RetryBaseDelay:       time.Duration(retryBaseDelay) * time.Second,
+		RetryMaxDelay:        time.Duration(retryMaxDelay) * time.Second,
+		RetryJitter:          retryJitter,
+		RetryableStatusCodes: retryableStatusCodes,
 		FunctionNameSpace:    wskNameSpace,
 		RiaasEndPoint:        riaasEndPoint,
 		IAMToken:             iamToken,
@@ -1455,6 +1577,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Visibility:           visibility,
 		EndpointsFile:        file,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		DefaultTags:          defaultTags,
+		DefaultAccessTags:    defaultAccessTags,
+		Endpoints:            endpoints,
+		CapacityChecks:       d.Get("capacity_checks").(string),
 	}
 
 	return config.ClientSession()
@@ -81,7 +81,7 @@ func getAppConfigClient(meta interface{}, guid string) (*appconfigurationv1.AppC
 		return nil, err
 	}
 	appConfigURL := fmt.Sprintf("https://%s.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/%s", bluemixSession.Config.Region, guid)
-	url := conns.EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_API_ENDPOINT"}, appConfigURL)
+	url := meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_APP_CONFIG_API_ENDPOINT", appConfigURL)
 	appconfigClient.Service.Options.URL = url
 	return appconfigClient, nil
 }
@@ -52,9 +52,14 @@ func ResourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 }
 
 func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	// Attachments of the network interface and of the security group are serialized
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	sgKey := "security_group_attachment_" + strconv.Itoa(d.Get("security_group_id").(int))
+	unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutCreate), mk, sgKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
@@ -62,7 +67,7 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 
 	sgID := d.Get("security_group_id").(int)
 	interfaceID := d.Get("network_interface_id").(int)
-	_, err := WaitForVSAvailable(d, meta, d.Timeout(schema.TimeoutCreate))
+	_, err = WaitForVSAvailable(d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -131,9 +136,14 @@ func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta in
 }
 
 func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	// Attachments of the network interface and of the security group are serialized
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	sgKey := "security_group_attachment_" + strconv.Itoa(d.Get("security_group_id").(int))
+	unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutDelete), mk, sgKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
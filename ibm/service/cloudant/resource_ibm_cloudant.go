@@ -126,6 +126,9 @@ func ResourceIBMCloudant() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
@@ -373,7 +376,7 @@ func getCloudantClient(d *schema.ResourceData, meta interface{}) (*cloudantv1.Cl
 		}
 	}
 
-	endpoint = conns.EnvFallBack([]string{"IBMCLOUD_CLOUDANT_ENDPOINT"}, endpoint)
+	endpoint = meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_CLOUDANT_ENDPOINT", endpoint)
 	if endpoint == "" {
 		return nil, fmt.Errorf("[ERROR] Missing endpoints.public in extensions")
 	}
@@ -409,7 +412,7 @@ func GetCloudantClientForUrl(endpoint string, meta interface{}) (*cloudantv1.Clo
 		}
 		authenticator = &core.IamAuthenticator{
 			ApiKey: apiKey,
-			URL:    conns.EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			URL:    meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_IAM_API_ENDPOINT", iamURL) + "/identity/token",
 		}
 	}
 
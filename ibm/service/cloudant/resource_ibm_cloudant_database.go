@@ -183,7 +183,7 @@ func GetCloudantInstanceUrl(instanceCRN string, meta interface{}) (string, error
 		instanceExtensionMap := flex.Flatten(instance.Extensions)
 		if instanceExtensionMap != nil {
 			cloudantInstanceUrl := "https://" + instanceExtensionMap["endpoints.public"]
-			cloudantInstanceUrl = conns.EnvFallBack([]string{"IBMCLOUD_CLOUDANT_API_ENDPOINT"}, cloudantInstanceUrl)
+			cloudantInstanceUrl = meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_CLOUDANT_API_ENDPOINT", cloudantInstanceUrl)
 			return cloudantInstanceUrl, nil
 		}
 	}
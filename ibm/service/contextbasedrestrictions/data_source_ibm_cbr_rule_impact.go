@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+// cbrProviderCallerName is the name of the egress context of the provider in
+// the impact results
+const cbrProviderCallerName = "provider"
+
+func DataSourceIBMCbrRuleImpact() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrRuleImpactRead,
+
+		Schema: map[string]*schema.Schema{
+			"rule_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"rule_id", "contexts"},
+				Description:  "The ID of the rule to evaluate.",
+			},
+			"contexts": &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"rule_id", "contexts"},
+				Description:  "The contexts of a rule that is not created yet.",
+				Elem:         ResourceIBMCbrRule().Schema["contexts"].Elem,
+			},
+			"caller_contexts": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The contexts the resources of the rule are called from.",
+				Elem:        cbrCallerContextResource(),
+			},
+			"include_provider_context": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Evaluates the egress context of the provider in addition to the caller contexts.",
+			},
+			"enforcement_mode": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enforcement mode of the rule.",
+			},
+			"zones": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The network zones of the rule with their addresses.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the zone.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone.",
+						},
+						"addresses": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The addresses of the zone as type:value.",
+						},
+						"excluded": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The excluded addresses of the zone as type:value.",
+						},
+					},
+				},
+			},
+			"results": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Whether the rule allows each caller context.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the caller context.",
+						},
+						"allowed": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the rule allows the caller context.",
+						},
+						"zone_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the zone that allows the caller context.",
+						},
+						"reason": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reason of the result.",
+						},
+					},
+				},
+			},
+			"denied_callers": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the caller contexts the rule denies once it is enabled.",
+			},
+		},
+	}
+}
+
+// cbrCallerContextResource is the schema of a context a request is made from
+func cbrCallerContextResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the caller context.",
+			},
+			"ip_address": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The IP address the caller calls from.",
+			},
+			"vpc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CRN of the VPC the caller calls from.",
+			},
+			"endpoint_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "The type of endpoint the caller calls.",
+			},
+			"service_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the service the caller is, for callers matched by service references.",
+			},
+			"service_instance": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The service instance the caller is.",
+			},
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The account of the service the caller is.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCbrRuleImpactRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var contexts []contextbasedrestrictionsv1.RuleContext
+	if ruleID, ok := d.GetOk("rule_id"); ok {
+		getRuleOptions := &contextbasedrestrictionsv1.GetRuleOptions{}
+		getRuleOptions.SetRuleID(ruleID.(string))
+
+		rule, response, err := contextBasedRestrictionsClient.GetRuleWithContext(context, getRuleOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+		}
+		contexts = rule.Contexts
+		if err = d.Set("enforcement_mode", rule.EnforcementMode); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting enforcement_mode: %s", err))
+		}
+	} else {
+		for _, e := range d.Get("contexts").([]interface{}) {
+			contextsItem, err := resourceIBMCbrRuleMapToRuleContext(e.(map[string]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			contexts = append(contexts, *contextsItem)
+		}
+	}
+
+	zoneIDs := flex.CbrRuleZoneIDs(contexts)
+	zones, err := resolveCbrZones(context, contextBasedRestrictionsClient, zoneIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	callers := expandCbrCallers(d.Get("caller_contexts").([]interface{}))
+	if d.Get("include_provider_context").(bool) {
+		if provider, ok := cbrProviderCaller(meta); ok {
+			callers = append([]flex.CbrCaller{provider}, callers...)
+		}
+	}
+
+	var diags diag.Diagnostics
+	results := make([]map[string]interface{}, 0, len(callers))
+	denied := []string{}
+	for _, caller := range callers {
+		decision, err := flex.EvaluateCbrRule(contexts, zones, caller)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		results = append(results, map[string]interface{}{
+			"name":    caller.Name,
+			"allowed": decision.Allowed,
+			"zone_id": decision.ZoneID,
+			"reason":  decision.Reason,
+		})
+		if !decision.Allowed {
+			denied = append(denied, caller.Name)
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The rule denies the %s caller context", caller.Name),
+				Detail:   fmt.Sprintf("Once the rule is enabled, requests from the %s caller context are denied: %s", caller.Name, decision.Reason),
+			})
+		}
+	}
+
+	flattenedZones := make([]map[string]interface{}, 0, len(zoneIDs))
+	for _, id := range zoneIDs {
+		flattenedZones = append(flattenedZones, flattenCbrZone(zones[id]))
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set("zones", flattenedZones); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting zones: %s", err))
+	}
+	if err = d.Set("results", results); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting results: %s", err))
+	}
+	if err = d.Set("denied_callers", denied); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting denied_callers: %s", err))
+	}
+
+	return diags
+}
+
+// resolveCbrZones gets the network zones with the IDs
+func resolveCbrZones(context context.Context, contextBasedRestrictionsClient *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, zoneIDs []string) (map[string]flex.CbrZone, error) {
+	zones := make(map[string]flex.CbrZone, len(zoneIDs))
+	for _, id := range zoneIDs {
+		getZoneOptions := &contextbasedrestrictionsv1.GetZoneOptions{}
+		getZoneOptions.SetZoneID(id)
+
+		zone, response, err := contextBasedRestrictionsClient.GetZoneWithContext(context, getZoneOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetZoneWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("GetZoneWithContext failed %s\n%s", err, response)
+		}
+		zones[id] = flex.NewCbrZone(zone)
+	}
+	return zones, nil
+}
+
+func expandCbrCallers(callerContexts []interface{}) []flex.CbrCaller {
+	callers := make([]flex.CbrCaller, 0, len(callerContexts))
+	for _, e := range callerContexts {
+		c := e.(map[string]interface{})
+		callers = append(callers, flex.CbrCaller{
+			Name:            c["name"].(string),
+			IPAddress:       c["ip_address"].(string),
+			VPC:             c["vpc"].(string),
+			EndpointType:    c["endpoint_type"].(string),
+			ServiceName:     c["service_name"].(string),
+			ServiceInstance: c["service_instance"].(string),
+			AccountID:       c["account_id"].(string),
+		})
+	}
+	return callers
+}
+
+// cbrProviderCaller returns the egress context of the provider, unless neither
+// its IP address nor its VPC is known
+func cbrProviderCaller(meta interface{}) (flex.CbrCaller, bool) {
+	sess, ok := meta.(conns.ClientSession)
+	if !ok {
+		return flex.CbrCaller{}, false
+	}
+	egress := sess.EgressContext()
+	if egress.IPAddress == "" && egress.VPC == "" {
+		log.Printf("[WARN] The egress context of the provider is not evaluated; set IC_EGRESS_IP_ADDRESS or IC_EGRESS_VPC_CRN to include it")
+		return flex.CbrCaller{}, false
+	}
+	return flex.CbrCaller{
+		Name:         cbrProviderCallerName,
+		IPAddress:    egress.IPAddress,
+		VPC:          egress.VPC,
+		EndpointType: egress.EndpointType,
+	}, true
+}
+
+func flattenCbrZone(zone flex.CbrZone) map[string]interface{} {
+	addresses := make([]string, 0, len(zone.Addresses))
+	for _, a := range zone.Addresses {
+		addresses = append(addresses, a.String())
+	}
+	excluded := make([]string, 0, len(zone.Excluded))
+	for _, a := range zone.Excluded {
+		excluded = append(excluded, a.String())
+	}
+	return map[string]interface{}{
+		"zone_id":   zone.ID,
+		"name":      zone.Name,
+		"addresses": addresses,
+		"excluded":  excluded,
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCbrRuleImpactDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleImpactDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_rule_impact.cbr_rule_impact", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "zones.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "zones.0.addresses.0", "ipRange:169.23.22.0-169.23.22.255"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "zones.0.excluded.0", "ipAddress:169.23.22.10"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "results.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "results.0.name", "office"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "results.0.allowed", "true"),
+					resource.TestCheckResourceAttrPair("data.ibm_cbr_rule_impact.cbr_rule_impact", "results.0.zone_id", "ibm_cbr_zone.cbr_zone", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "results.1.allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "results.2.allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "denied_callers.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "denied_callers.0", "excluded"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "denied_callers.1", "private"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCbrRuleImpactDataSourceRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleImpactDataSourceConfigRule(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "enforcement_mode", "report"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "zones.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_impact.cbr_rule_impact", "denied_callers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrRuleImpactDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "Test Rule Impact Data Source Config Basic"
+			description = "Test Rule Impact Data Source Config Basic"
+			account_id = "12ab34cd56ef78ab90cd12ef34ab56cd"
+			addresses {
+				type = "ipRange"
+				value = "169.23.22.0-169.23.22.255"
+			}
+			excluded {
+				type = "ipAddress"
+				value = "169.23.22.10"
+			}
+		}
+
+		data "ibm_cbr_rule_impact" "cbr_rule_impact" {
+			contexts {
+				attributes {
+					name = "networkZoneId"
+					value = ibm_cbr_zone.cbr_zone.id
+				}
+				attributes {
+					name = "endpointType"
+					value = "public"
+				}
+			}
+			include_provider_context = false
+			caller_contexts {
+				name = "office"
+				ip_address = "169.23.22.20"
+			}
+			caller_contexts {
+				name = "excluded"
+				ip_address = "169.23.22.10"
+			}
+			caller_contexts {
+				name = "private"
+				ip_address = "169.23.22.20"
+				endpoint_type = "private"
+			}
+		}
+	`)
+}
+
+func testAccCheckIBMCbrRuleImpactDataSourceConfigRule() string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "Test Rule Impact Data Source Config Rule"
+			description = "Test Rule Impact Data Source Config Rule"
+			account_id = "12ab34cd56ef78ab90cd12ef34ab56cd"
+			addresses {
+				type = "ipAddress"
+				value = "169.23.56.234"
+			}
+		}
+
+		resource "ibm_cbr_rule" "cbr_rule" {
+			description = "Test Rule Impact Data Source Config Rule"
+			contexts {
+				attributes {
+					name = "networkZoneId"
+					value = ibm_cbr_zone.cbr_zone.id
+				}
+			}
+			resources {
+				attributes {
+					name = "accountId"
+					value = "12ab34cd56ef78ab90cd12ef34ab56cd"
+				}
+				attributes {
+					name = "serviceName"
+					value = "kms"
+				}
+			}
+			enforcement_mode = "report"
+		}
+
+		data "ibm_cbr_rule_impact" "cbr_rule_impact" {
+			rule_id = ibm_cbr_rule.cbr_rule.id
+			include_provider_context = false
+			caller_contexts {
+				name = "office"
+				ip_address = "169.23.56.234"
+			}
+		}
+	`)
+}
@@ -24,6 +24,7 @@ func ResourceIBMCbrRule() *schema.Resource {
 		ReadContext:   resourceIBMCbrRuleRead,
 		UpdateContext: resourceIBMCbrRuleUpdate,
 		DeleteContext: resourceIBMCbrRuleDelete,
+		CustomizeDiff: resourceIBMCbrRuleLockoutCheck,
 		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
@@ -148,6 +149,18 @@ func ResourceIBMCbrRule() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_cbr_rule", "enforcement_mode"),
 				Description:  "The rule enforcement mode: * `enabled` - The restrictions are enforced and reported. This is the default. * `disabled` - The restrictions are disabled. Nothing is enforced or reported. * `report` - The restrictions are evaluated and reported, but not enforced.",
 			},
+			"caller_contexts": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The contexts the resources of the rule are called from, which the lockout check evaluates in addition to the egress context of the provider.",
+				Elem:        cbrCallerContextResource(),
+			},
+			"lockout_check": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cbr_rule", "lockout_check"),
+				Description:  "Whether a plan that enables a rule denying the provider or a caller context fails (`error`, the default when not set) or is not checked (`off`). The provider is only checked when the IC_EGRESS_IP_ADDRESS or IC_EGRESS_VPC_CRN environment variable is set.",
+			},
 			"x_correlation_id": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -217,6 +230,13 @@ func ResourceIBMCbrRuleValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			AllowedValues:              "disabled, enabled, report",
 		},
+		validate.ValidateSchema{
+			Identifier:                 "lockout_check",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "off, error",
+		},
 		validate.ValidateSchema{
 			Identifier:                 "x_correlation_id",
 			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
@@ -397,6 +417,11 @@ func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, met
 }
 
 func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The lockout check and the caller contexts are only used at plan time
+	if !d.HasChangesExcept("lockout_check", "caller_contexts") {
+		return resourceIBMCbrRuleRead(context, d, meta)
+	}
+
 	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
 	if err != nil {
 		return diag.FromErr(err)
@@ -480,6 +505,67 @@ func resourceIBMCbrRuleDelete(context context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// resourceIBMCbrRuleLockoutCheck fails the plan of an enabled rule that denies
+// the egress context of the provider or a caller context
+func resourceIBMCbrRuleLockoutCheck(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("lockout_check").(string) == "off" || diff.Get("enforcement_mode").(string) != "enabled" {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("contexts") && !diff.HasChange("enforcement_mode") && !diff.HasChange("caller_contexts") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("contexts") || !diff.NewValueKnown("caller_contexts") {
+		log.Printf("[WARN] Skipping the lockout check of the rule: its contexts are not known until apply")
+		return nil
+	}
+	var contexts []contextbasedrestrictionsv1.RuleContext
+	for i, e := range diff.Get("contexts").([]interface{}) {
+		value := e.(map[string]interface{})
+		for j := range value["attributes"].([]interface{}) {
+			if !diff.NewValueKnown(fmt.Sprintf("contexts.%d.attributes.%d.value", i, j)) {
+				log.Printf("[WARN] Skipping the lockout check of the rule: its contexts are not known until apply")
+				return nil
+			}
+		}
+		contextsItem, err := resourceIBMCbrRuleMapToRuleContext(value)
+		if err != nil {
+			return err
+		}
+		contexts = append(contexts, *contextsItem)
+	}
+
+	callers := expandCbrCallers(diff.Get("caller_contexts").([]interface{}))
+	if provider, ok := cbrProviderCaller(meta); ok {
+		callers = append([]flex.CbrCaller{provider}, callers...)
+	}
+	if len(callers) == 0 {
+		return nil
+	}
+
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return err
+	}
+	zones, err := resolveCbrZones(context, contextBasedRestrictionsClient, flex.CbrRuleZoneIDs(contexts))
+	if err != nil {
+		log.Printf("[WARN] Skipping the lockout check of the rule: %s", err)
+		return nil
+	}
+
+	for _, caller := range callers {
+		decision, err := flex.EvaluateCbrRule(contexts, zones, caller)
+		if err != nil {
+			return err
+		}
+		if decision.Allowed {
+			continue
+		}
+		return fmt.Errorf("[ERROR] The rule denies requests from the %s caller context once it is applied: %s. Set lockout_check to off to apply the rule anyway", caller.Name, decision.Reason)
+	}
+	return nil
+}
+
 func resourceIBMCbrRuleMapToRuleContext(modelMap map[string]interface{}) (*contextbasedrestrictionsv1.RuleContext, error) {
 	model := &contextbasedrestrictionsv1.RuleContext{}
 	attributes := []contextbasedrestrictionsv1.RuleContextAttribute{}
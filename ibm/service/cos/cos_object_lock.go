@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/private/checksum"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// The IBM COS SDK does not implement the S3 Object Lock operations, which COS
+// supports. They are sent with the protocol handlers of the S3 client, from
+// inputs and outputs tagged like those of the SDK.
+
+const (
+	cosObjectLockModeCompliance = "COMPLIANCE"
+	cosObjectLockEnabled        = "Enabled"
+)
+
+type cosObjectLockConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	ObjectLockEnabled *string `type:"string"`
+
+	Rule *cosObjectLockRule `type:"structure"`
+}
+
+type cosObjectLockRule struct {
+	_ struct{} `type:"structure"`
+
+	DefaultRetention *cosObjectLockDefaultRetention `type:"structure"`
+}
+
+type cosObjectLockDefaultRetention struct {
+	_ struct{} `type:"structure"`
+
+	Days *int64 `type:"integer"`
+
+	Mode *string `type:"string"`
+
+	Years *int64 `type:"integer"`
+}
+
+type cosObjectRetention struct {
+	_ struct{} `type:"structure"`
+
+	Mode *string `type:"string"`
+
+	RetainUntilDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+}
+
+type cosObjectLegalHold struct {
+	_ struct{} `type:"structure"`
+
+	Status *string `type:"string"`
+}
+
+type putCOSObjectLockConfigurationInput struct {
+	_ struct{} `locationName:"PutObjectLockConfigurationRequest" type:"structure" payload:"ObjectLockConfiguration"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ObjectLockConfiguration *cosObjectLockConfiguration `locationName:"ObjectLockConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type getCOSObjectLockConfigurationInput struct {
+	_ struct{} `locationName:"GetObjectLockConfigurationRequest" type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+type getCOSObjectLockConfigurationOutput struct {
+	_ struct{} `type:"structure" payload:"ObjectLockConfiguration"`
+
+	ObjectLockConfiguration *cosObjectLockConfiguration `type:"structure"`
+}
+
+type putCOSObjectRetentionInput struct {
+	_ struct{} `locationName:"PutObjectRetentionRequest" type:"structure" payload:"Retention"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	Key *string `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+
+	Retention *cosObjectRetention `locationName:"Retention" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type getCOSObjectRetentionInput struct {
+	_ struct{} `locationName:"GetObjectRetentionRequest" type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	Key *string `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+}
+
+type getCOSObjectRetentionOutput struct {
+	_ struct{} `type:"structure" payload:"Retention"`
+
+	Retention *cosObjectRetention `type:"structure"`
+}
+
+type putCOSObjectLegalHoldInput struct {
+	_ struct{} `locationName:"PutObjectLegalHoldRequest" type:"structure" payload:"LegalHold"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	Key *string `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+
+	LegalHold *cosObjectLegalHold `locationName:"LegalHold" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type getCOSObjectLegalHoldInput struct {
+	_ struct{} `locationName:"GetObjectLegalHoldRequest" type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	Key *string `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+}
+
+type getCOSObjectLegalHoldOutput struct {
+	_ struct{} `type:"structure" payload:"LegalHold"`
+
+	LegalHold *cosObjectLegalHold `type:"structure"`
+}
+
+type putCOSObjectLockOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// sendCOSRequest sends the operation with the handlers of the client. The
+// Object Lock operations that change a configuration require a Content-MD5.
+func sendCOSRequest(ctx context.Context, s3Client *s3.S3, op *request.Operation, input, output interface{}) error {
+	req := s3Client.NewRequest(op, input, output)
+	req.SetContext(ctx)
+	if op.HTTPMethod == "PUT" {
+		req.Handlers.Build.PushBackNamed(request.NamedHandler{
+			Name: "contentMd5Handler",
+			Fn:   checksum.AddBodyContentMD5Handler,
+		})
+	}
+	return req.Send()
+}
+
+func putCOSObjectLockConfiguration(ctx context.Context, s3Client *s3.S3, input *putCOSObjectLockConfigurationInput) error {
+	op := &request.Operation{
+		Name:       "PutObjectLockConfiguration",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?object-lock",
+	}
+	return sendCOSRequest(ctx, s3Client, op, input, &putCOSObjectLockOutput{})
+}
+
+// getCOSObjectLockConfiguration returns the Object Lock configuration of the
+// bucket, or nil when Object Lock is not enabled
+func getCOSObjectLockConfiguration(ctx context.Context, s3Client *s3.S3, input *getCOSObjectLockConfigurationInput) (*cosObjectLockConfiguration, error) {
+	op := &request.Operation{
+		Name:       "GetObjectLockConfiguration",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?object-lock",
+	}
+	output := &getCOSObjectLockConfigurationOutput{}
+	if err := sendCOSRequest(ctx, s3Client, op, input, output); err != nil {
+		if isCOSObjectLockNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return output.ObjectLockConfiguration, nil
+}
+
+func putCOSObjectRetention(ctx context.Context, s3Client *s3.S3, input *putCOSObjectRetentionInput) error {
+	op := &request.Operation{
+		Name:       "PutObjectRetention",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}/{Key+}?retention",
+	}
+	return sendCOSRequest(ctx, s3Client, op, input, &putCOSObjectLockOutput{})
+}
+
+// getCOSObjectRetention returns the retention of the object, or nil when it
+// has none
+func getCOSObjectRetention(ctx context.Context, s3Client *s3.S3, input *getCOSObjectRetentionInput) (*cosObjectRetention, error) {
+	op := &request.Operation{
+		Name:       "GetObjectRetention",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}/{Key+}?retention",
+	}
+	output := &getCOSObjectRetentionOutput{}
+	if err := sendCOSRequest(ctx, s3Client, op, input, output); err != nil {
+		if isCOSObjectLockNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return output.Retention, nil
+}
+
+func putCOSObjectLegalHold(ctx context.Context, s3Client *s3.S3, input *putCOSObjectLegalHoldInput) error {
+	op := &request.Operation{
+		Name:       "PutObjectLegalHold",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}/{Key+}?legal-hold",
+	}
+	return sendCOSRequest(ctx, s3Client, op, input, &putCOSObjectLockOutput{})
+}
+
+// getCOSObjectLegalHold returns the legal hold of the object, or nil when it
+// has none
+func getCOSObjectLegalHold(ctx context.Context, s3Client *s3.S3, input *getCOSObjectLegalHoldInput) (*cosObjectLegalHold, error) {
+	op := &request.Operation{
+		Name:       "GetObjectLegalHold",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}/{Key+}?legal-hold",
+	}
+	output := &getCOSObjectLegalHoldOutput{}
+	if err := sendCOSRequest(ctx, s3Client, op, input, output); err != nil {
+		if isCOSObjectLockNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return output.LegalHold, nil
+}
+
+// isCOSObjectLockNotFound returns whether the error is returned for a bucket
+// without Object Lock, or an object without retention or legal hold. Reading
+// the retention or legal hold of an object in a bucket without Object Lock is
+// an InvalidRequest, which is not matched since it is not checked for.
+func isCOSObjectLockNotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case "ObjectLockConfigurationNotFoundError", "NoSuchObjectLockConfiguration":
+			return true
+		}
+	}
+	return false
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/migrate"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
@@ -20,6 +21,7 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -60,14 +62,17 @@ func resourceinstanceidDiffSuppress(_, old, new string, _ *schema.ResourceData)
 	return false
 }
 func ResourceIBMCOSBucket() *schema.Resource {
-	return &schema.Resource{
-		Read:          resourceIBMCOSBucketRead,
-		Create:        resourceIBMCOSBucketCreate,
-		Update:        resourceIBMCOSBucketUpdate,
-		Delete:        resourceIBMCOSBucketDelete,
-		Exists:        resourceIBMCOSBucketExists,
-		Importer:      &schema.ResourceImporter{},
-		CustomizeDiff: resourceExpiryValidate,
+	return migrate.Versioned(&schema.Resource{
+		Read:     resourceIBMCOSBucketRead,
+		Create:   resourceIBMCOSBucketCreate,
+		Update:   resourceIBMCOSBucketUpdate,
+		Delete:   resourceIBMCOSBucketDelete,
+		Exists:   resourceIBMCOSBucketExists,
+		Importer: &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.All(
+			resourceExpiryValidate,
+			resourceObjectLockValidate,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -400,6 +405,46 @@ func ResourceIBMCOSBucket() *schema.Resource {
 					},
 				},
 			},
+			"object_lock": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"retention_rule"},
+				Description:   "Enable Object Lock to protect the objects in the bucket from deletion or overwrite for a retention period. Requires object versioning, and cannot be disabled once enabled, so removing the block leaves Object Lock as it is.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_retention": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The retention applied to the objects stored in the bucket without a retention",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{cosObjectLockModeCompliance}),
+										Description:  "The retention mode, COMPLIANCE",
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 36500),
+										Description:  "The retention period in days",
+									},
+									"years": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 100),
+										Description:  "The retention period in years",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"noncurrent_version_expiration": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -445,7 +490,12 @@ func ResourceIBMCOSBucket() *schema.Resource {
 				Description: "COS buckets need to be empty before they can be deleted. force_delete option empty the bucket and delete it.",
 			},
 		},
-	}
+	}, migrate.Upgrade{
+		// endpoint_type and force_delete are set in the state of the buckets
+		// created before they were added
+		Version: 0,
+		Upgrade: resourceIBMCOSBucketStateUpgradeV0,
+	})
 }
 func ResourceIBMCOSBucketValidator() *validate.ResourceValidator {
 
@@ -762,7 +812,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
 	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithEndpoint(meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_COS_ENDPOINT", apiEndpoint)).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
 	}
 	iamAccessToken := rsConClient.Config.IAMAccessToken
 	if iamAccessToken != "" {
@@ -775,7 +825,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
 			}, nil
 		}
-		s3Conf = aws.NewConfig().WithEndpoint(conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
+		s3Conf = aws.NewConfig().WithEndpoint(meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_COS_ENDPOINT", apiEndpoint)).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
@@ -912,6 +962,20 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	//update the object lock configuration, which requires the object versioning
+	if d.HasChange("object_lock") {
+		if objectLock := d.Get("object_lock").([]interface{}); len(objectLock) > 0 {
+			input := &putCOSObjectLockConfigurationInput{
+				Bucket:                  aws.String(bucketName),
+				ObjectLockConfiguration: expandCOSObjectLockConfiguration(objectLock),
+			}
+			err := putCOSObjectLockConfiguration(context.Background(), s3Client, input)
+			if err != nil {
+				return fmt.Errorf("failed to update the object lock configuration on COS bucket %s, %v", bucketName, err)
+			}
+		}
+	}
+
 	sess, err := meta.(conns.ClientSession).CosConfigV1API()
 	if err != nil {
 		return err
@@ -1051,7 +1115,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	}
 
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 
@@ -1249,6 +1313,20 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("object_versioning", nil)
 		}
 	}
+
+	// Read Object Lock configuration
+	objectLockInput := &getCOSObjectLockConfigurationInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	objectLockPtr, err := getCOSObjectLockConfiguration(context.Background(), s3Client, objectLockInput)
+
+	if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
+		return err
+	}
+	if err == nil {
+		d.Set("object_lock", flattenCOSObjectLockConfiguration(objectLockPtr))
+	}
 	return nil
 }
 
@@ -1314,7 +1392,7 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 
 	if apiEndpoint == "" {
 		return fmt.Errorf("[ERROR] The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType)
@@ -1423,7 +1501,7 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 
 	if apiEndpoint == "" {
 		return fmt.Errorf("[ERROR] The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType)
@@ -1532,7 +1610,7 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	}
 
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 
 	if apiEndpoint == "" {
 		return false, fmt.Errorf("[ERROR] The endpoint doesn't exists for given endpoint type %s", endpointType)
@@ -1624,6 +1702,21 @@ func parseBucketId(id string, info string) string {
 	return ""
 }
 
+func resourceIBMCOSBucketStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	endpointType := "public"
+	if id, ok := rawState["id"].(string); ok && strings.Contains(id, ":meta:") {
+		if t := parseBucketId(id, "endpointType"); t != "" {
+			endpointType = t
+		}
+	}
+	if migrate.IsEmpty(rawState["endpoint_type"]) {
+		rawState["endpoint_type"] = endpointType
+	}
+	// A null force_delete does not empty the bucket before deleting it, unlike its default
+	migrate.SetDefault(rawState, "force_delete", true)
+	return rawState, nil
+}
+
 func resourceExpiryValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	if expire, ok := diff.GetOk("expire_rule"); ok {
 		expire_list := expire.([]interface{})
@@ -1646,3 +1739,79 @@ func resourceExpiryValidate(_ context.Context, diff *schema.ResourceDiff, meta i
 	}
 	return nil
 }
+
+// resourceObjectLockValidate validates that the object versioning Object Lock
+// requires is enabled. Object Lock cannot be disabled once enabled, so
+// object_lock is computed and removing it leaves Object Lock as it is.
+func resourceObjectLockValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	objectLock := diff.Get("object_lock").([]interface{})
+	if len(objectLock) == 0 {
+		return nil
+	}
+
+	versioningEnabled := false
+	for _, l := range diff.Get("object_versioning").([]interface{}) {
+		if versioningMap, ok := l.(map[string]interface{}); ok {
+			versioningEnabled = versioningMap["enable"].(bool)
+		}
+	}
+	if !versioningEnabled {
+		return fmt.Errorf("[ERROR] Object Lock requires object versioning. Set enable to true in the object_versioning of the COS bucket")
+	}
+
+	if objectLockMap, ok := objectLock[0].(map[string]interface{}); ok {
+		for _, r := range objectLockMap["default_retention"].([]interface{}) {
+			retention, _ := r.(map[string]interface{})
+			if (retention["days"].(int) == 0) == (retention["years"].(int) == 0) {
+				return fmt.Errorf("[ERROR] The default_retention of the object_lock must set one of days or years")
+			}
+		}
+	}
+	return nil
+}
+
+func expandCOSObjectLockConfiguration(objectLock []interface{}) *cosObjectLockConfiguration {
+	configuration := &cosObjectLockConfiguration{
+		ObjectLockEnabled: aws.String(cosObjectLockEnabled),
+	}
+	objectLockMap, ok := objectLock[0].(map[string]interface{})
+	if !ok {
+		return configuration
+	}
+	for _, r := range objectLockMap["default_retention"].([]interface{}) {
+		retention, _ := r.(map[string]interface{})
+		defaultRetention := &cosObjectLockDefaultRetention{
+			Mode: aws.String(retention["mode"].(string)),
+		}
+		if days := retention["days"].(int); days > 0 {
+			defaultRetention.Days = aws.Int64(int64(days))
+		}
+		if years := retention["years"].(int); years > 0 {
+			defaultRetention.Years = aws.Int64(int64(years))
+		}
+		configuration.Rule = &cosObjectLockRule{
+			DefaultRetention: defaultRetention,
+		}
+	}
+	return configuration
+}
+
+func flattenCOSObjectLockConfiguration(configuration *cosObjectLockConfiguration) []interface{} {
+	if configuration == nil || aws.StringValue(configuration.ObjectLockEnabled) != cosObjectLockEnabled {
+		return nil
+	}
+	defaultRetention := []interface{}{}
+	if configuration.Rule != nil && configuration.Rule.DefaultRetention != nil {
+		retention := configuration.Rule.DefaultRetention
+		defaultRetention = append(defaultRetention, map[string]interface{}{
+			"mode":  aws.StringValue(retention.Mode),
+			"days":  int(aws.Int64Value(retention.Days)),
+			"years": int(aws.Int64Value(retention.Years)),
+		})
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"default_retention": defaultRetention,
+		},
+	}
+}
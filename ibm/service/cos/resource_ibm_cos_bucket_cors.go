@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketCors() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketCorsCreate,
+		Read:     resourceIBMCOSBucketCorsRead,
+		Update:   resourceIBMCOSBucketCorsCreate,
+		Delete:   resourceIBMCOSBucketCorsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    100,
+				Description: "Cross-origin requests allowed on the bucket. Up to 100 rules can be added.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers allowed in the Access-Control-Request-Headers header of preflight requests",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.ValidateAllowedStringValues([]string{"GET", "PUT", "HEAD", "POST", "DELETE"}),
+							},
+							Description: "HTTP methods allowed: GET, PUT, HEAD, POST, DELETE",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Origins allowed to access the bucket, for example https://www.example.com or *",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Response headers the browsers can access",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The time in seconds browsers can cache the response to a preflight request",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMCOSBucketCorsCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	var rules []*s3.CORSRule
+	for _, r := range d.Get("cors_rule").([]interface{}) {
+		rule, _ := r.(map[string]interface{})
+		corsRule := &s3.CORSRule{
+			AllowedHeaders: aws.StringSlice(flex.ExpandStringList(rule["allowed_headers"].([]interface{}))),
+			AllowedMethods: aws.StringSlice(flex.ExpandStringList(rule["allowed_methods"].([]interface{}))),
+			AllowedOrigins: aws.StringSlice(flex.ExpandStringList(rule["allowed_origins"].([]interface{}))),
+			ExposeHeaders:  aws.StringSlice(flex.ExpandStringList(rule["expose_headers"].([]interface{}))),
+		}
+		if maxAge := rule["max_age_seconds"].(int); maxAge > 0 {
+			corsRule.MaxAgeSeconds = aws.Int64(int64(maxAge))
+		}
+		rules = append(rules, corsRule)
+	}
+	putBucketCorsInput := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucketName),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: rules,
+		},
+	}
+
+	_, err = s3Client.PutBucketCors(putBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error setting the CORS configuration on COS bucket %s, %v", bucketName, err)
+	}
+
+	d.SetId(bucketConfigurationID(instanceCRN, bucketName, bucketLocation, endpointType))
+
+	return resourceIBMCOSBucketCorsRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	getBucketCorsInput := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	cors, err := s3Client.GetBucketCors(getBucketCorsInput)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "NoSuchCORSConfiguration" || aerr.Code() == s3.ErrCodeNoSuchBucket) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting the CORS configuration of COS bucket %s, %v", bucketName, err)
+	}
+
+	rules := make([]map[string]interface{}, 0, len(cors.CORSRules))
+	for _, rule := range cors.CORSRules {
+		rules = append(rules, map[string]interface{}{
+			"allowed_headers": aws.StringValueSlice(rule.AllowedHeaders),
+			"allowed_methods": aws.StringValueSlice(rule.AllowedMethods),
+			"allowed_origins": aws.StringValueSlice(rule.AllowedOrigins),
+			"expose_headers":  aws.StringValueSlice(rule.ExposeHeaders),
+			"max_age_seconds": int(aws.Int64Value(rule.MaxAgeSeconds)),
+		})
+	}
+	d.Set("cors_rule", rules)
+	return nil
+}
+
+func resourceIBMCOSBucketCorsDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	deleteBucketCorsInput := &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeleteBucketCors(deleteBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting the CORS configuration of COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
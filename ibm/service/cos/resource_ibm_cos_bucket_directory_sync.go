@@ -0,0 +1,404 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketDirectorySyncCreate,
+		ReadContext:   resourceIBMCOSBucketDirectorySyncRead,
+		UpdateContext: resourceIBMCOSBucketDirectorySyncUpdate,
+		DeleteContext: resourceIBMCOSBucketDirectorySyncDelete,
+
+		CustomizeDiff: resourceIBMCOSBucketDirectorySyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the local directory synced to the bucket",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Key prefix the files of the directory are uploaded under",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files that are not synced",
+			},
+			"delete_removed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Delete the objects of the files removed from the directory",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "Part size in MiB of multipart uploads. Files larger than a part are uploaded in parts",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "Number of parts uploaded in parallel",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "SHA-256 hexdigests of the synced objects, by object key",
+			},
+		},
+	}
+}
+
+// cosDirectoryFile is a file of the synced directory
+type cosDirectoryFile struct {
+	path          string
+	contentSHA256 string
+}
+
+func resourceIBMCOSBucketDirectorySyncCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("prefix") || !diff.NewValueKnown("exclude") {
+		return diff.SetNewComputed("files")
+	}
+
+	sourceDir := diff.Get("source_dir").(string)
+	// The directory may be created by another resource during the apply
+	if _, err := os.Stat(sourceDir); os.IsNotExist(err) {
+		return diff.SetNewComputed("files")
+	}
+
+	files, err := cosDirectoryFiles(sourceDir, diff.Get("prefix").(string), flex.ExpandStringList(diff.Get("exclude").([]interface{})))
+	if err != nil {
+		return err
+	}
+	synced := flattenCOSDirectoryFiles(files)
+
+	old := diff.Get("files").(map[string]interface{})
+	if len(old) != len(synced) {
+		return diff.SetNew("files", synced)
+	}
+	for key, contentSHA256 := range synced {
+		if old[key] != contentSHA256 {
+			return diff.SetNew("files", synced)
+		}
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	prefix := d.Get("prefix").(string)
+
+	if diags := syncCOSBucketDirectory(ctx, d, m, map[string]interface{}{}); diags != nil {
+		return diags
+	}
+	d.SetId(getObjectId(bucketCRN, prefix, bucketLocation))
+
+	return resourceIBMCOSBucketDirectorySyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketDirectorySyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	objectID := d.Id()
+
+	bucketName := parseObjectId(objectID, "bucketName")
+	bucketLocation := parseObjectId(objectID, "bucketLocation")
+	instanceCRN := parseObjectId(objectID, "instanceCRN")
+	endpointType := d.Get("endpoint_type").(string)
+	prefix := parseObjectId(objectID, "objectKey")
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listInput := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	}
+	objects := map[string]bool{}
+	err = s3Client.ListObjectsV2PagesWithContext(ctx, listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = true
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing objects of COS bucket (%s) with prefix (%s): %w", bucketName, prefix, err))
+	}
+
+	// Objects deleted outside of Terraform are uploaded again by the next apply
+	files := map[string]interface{}{}
+	for key, contentSHA256 := range d.Get("files").(map[string]interface{}) {
+		if objects[key] {
+			files[key] = contentSHA256
+		} else {
+			log.Printf("[WARN] COS bucket (%s) object (%s) not found", bucketName, key)
+		}
+	}
+
+	d.Set("bucket_crn", parseObjectId(objectID, "bucketCRN"))
+	d.Set("bucket_location", bucketLocation)
+	d.Set("prefix", prefix)
+	d.Set("files", files)
+	return nil
+}
+
+func resourceIBMCOSBucketDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("source_dir", "exclude", "delete_removed", "files") {
+		old, _ := d.GetChange("files")
+		if diags := syncCOSBucketDirectory(ctx, d, m, old.(map[string]interface{})); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMCOSBucketDirectorySyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for key := range d.Get("files").(map[string]interface{}) {
+		if err := deleteCOSObjectVersion(s3Client, bucketName, key, "", false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// syncCOSBucketDirectory uploads the files of the directory whose digest
+// differs from the synced one, and deletes the objects of the removed files
+func syncCOSBucketDirectory(ctx context.Context, d *schema.ResourceData, m interface{}, synced map[string]interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sourceDir := d.Get("source_dir").(string)
+	files, err := cosDirectoryFiles(sourceDir, d.Get("prefix").(string), flex.ExpandStringList(d.Get("exclude").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	partSize := d.Get("part_size").(int)
+	concurrency := d.Get("upload_concurrency").(int)
+	for _, key := range keys {
+		file := files[key]
+		if synced[key] == file.contentSHA256 {
+			continue
+		}
+		if err := uploadCOSDirectoryFile(ctx, s3Client, bucketName, key, file, partSize, concurrency); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("delete_removed").(bool) {
+		for key := range synced {
+			if _, ok := files[key]; ok {
+				continue
+			}
+			if err := deleteCOSObjectVersion(s3Client, bucketName, key, "", false); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.Set("files", flattenCOSDirectoryFiles(files))
+	return nil
+}
+
+func uploadCOSDirectoryFile(ctx context.Context, s3Client *s3.S3, bucketName, key string, file cosDirectoryFile, partSize, concurrency int) error {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", file.path, err)
+	}
+	defer func() {
+		err := body.Close()
+		if err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", file.path, err)
+		}
+	}()
+
+	uploadInput := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	}
+	if contentType := mime.TypeByExtension(filepath.Ext(file.path)); contentType != "" {
+		uploadInput.ContentType = aws.String(contentType)
+	}
+
+	log.Printf("[INFO] Uploading %s to COS bucket (%s) object (%s)", file.path, bucketName, key)
+	if err := uploadCOSObject(ctx, s3Client, uploadInput, body, file.contentSHA256, partSize, concurrency); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", key, bucketName, err)
+	}
+	return nil
+}
+
+// cosDirectoryFiles returns the files of the directory that are not excluded,
+// by the object key they are synced to
+func cosDirectoryFiles(sourceDir, prefix string, exclude []string) (map[string]cosDirectoryFile, error) {
+	files := map[string]cosDirectoryFile{}
+	err := filepath.Walk(sourceDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		excluded, err := cosDirectoryFileExcluded(rel, exclude)
+		if err != nil {
+			return err
+		}
+		if excluded {
+			return nil
+		}
+
+		body, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+		contentSHA256, err := cosObjectContentSHA256(body)
+		if err != nil {
+			return err
+		}
+		files[prefix+rel] = cosDirectoryFile{
+			path:          p,
+			contentSHA256: contentSHA256,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading COS directory (%s): %s", sourceDir, err)
+	}
+	return files, nil
+}
+
+// cosDirectoryFileExcluded returns whether a pattern matches the path of the
+// file relative to the directory, or its name for patterns without a slash
+func cosDirectoryFileExcluded(rel string, exclude []string) (bool, error) {
+	for _, pattern := range exclude {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid exclude pattern %q: %s", pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func flattenCOSDirectoryFiles(files map[string]cosDirectoryFile) map[string]interface{} {
+	m := make(map[string]interface{}, len(files))
+	for key, file := range files {
+		m[key] = file.contentSHA256
+	}
+	return m
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketDirectorySync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	sourceDir := "../../test-fixtures/cosDirectory"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketDirectorySyncConfig(name, instanceCRN, sourceDir, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_directory_sync.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_directory_sync.testacc", "files.%", "3"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_directory_sync.testacc", "files.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_directory_sync.testacc", "files.site/css/site.css"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketDirectorySyncConfig(name, instanceCRN, sourceDir, "*.tmp"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_directory_sync.testacc", "files.%", "2"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_directory_sync.testacc", "files.site/build.tmp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketDirectorySyncConfig(name string, instanceCRN string, sourceDir string, exclude string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_directory_sync" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source_dir      = "%[3]s"
+			prefix          = "site/"
+			exclude         = compact(["%[4]s"])
+		}`, name, instanceCRN, sourceDir, exclude)
+}
@@ -6,10 +6,13 @@ package cos
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
+	"net/url"
 	"os"
 	"regexp"
 	"strings"
@@ -24,10 +27,18 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// cosObjectSHA256MetadataKey is the user metadata key the SHA-256 hexdigest
+// of the object content is stored under, as the etag of an object uploaded in
+// parts is not a digest of its content
+const cosObjectSHA256MetadataKey = "content-sha256"
+
 func ResourceIBMCOSBucketObject() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMCOSBucketObjectCreate,
@@ -36,6 +47,11 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 		DeleteContext: resourceIBMCOSBucketObjectDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.All(
+			resourceIBMCOSBucketObjectCustomizeDiff,
+			resourceIBMCOSBucketObjectLockValidate,
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -82,8 +98,16 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Computed:    true,
 				Description: "COS object content length",
 			},
+			"content_sha256": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-f]{64}$`), "must be a lowercase SHA-256 hexdigest"),
+				Description:  "COS object content SHA-256 hexdigest, used to trigger updates of content_file",
+			},
 			"content_type": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "COS object content type",
 			},
@@ -95,9 +119,14 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:      "public",
 			},
 			"etag": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				// The etag of an object uploaded in parts is not the MD5
+				// hexdigest of its content, changes are tracked by content_sha256
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.Contains(old, "-")
+				},
 				Description: "COS object MD5 hexdigest",
 			},
 			"key": {
@@ -111,6 +140,56 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Computed:    true,
 				Description: "COS object last modified date",
 			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateCOSObjectMetadata,
+				Description:  "COS object user metadata",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "COS object tags",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "Part size in MiB of multipart uploads. Content larger than a part is uploaded in parts",
+			},
+			"object_lock_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"object_lock_retain_until_date"},
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{cosObjectLockModeCompliance}),
+				Description:  "COS object retention mode, COMPLIANCE",
+			},
+			"object_lock_retain_until_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"object_lock_mode"},
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "COS object retention expiration date in RFC3339 format",
+			},
+			"object_lock_legal_hold_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"ON", "OFF"}),
+				Description:  "COS object legal hold status, ON or OFF",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "Number of parts uploaded in parallel",
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -143,7 +222,7 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -160,47 +239,17 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("[ERROR] Error COS bucket (%s) object (%s) already exists", bucketName, objectKey))
 	}
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
-
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := putCOSBucketObject(ctx, d, s3Client, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
 	}
 
 	objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
 	d.SetId(objectID)
 
+	if err := putCOSBucketObjectLock(ctx, d, s3Client, bucketName, objectKey, true); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
 }
 
@@ -221,7 +270,7 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -245,6 +294,78 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("content_length", out.ContentLength)
 	d.Set("content_type", out.ContentType)
 	d.Set("etag", strings.Trim(aws.StringValue(out.ETag), `"`))
+
+	contentSHA256, metadata := flattenCOSObjectMetadata(out.Metadata)
+	if contentSHA256 != "" {
+		d.Set("content_sha256", contentSHA256)
+	}
+	d.Set("metadata", metadata)
+
+	// The tags are only read while they are set, since reading them needs a
+	// permission of its own that not all users have
+	if _, ok := d.GetOk("tags"); ok {
+		tagging, err := s3Client.GetObjectTaggingWithContext(ctx, &s3.GetObjectTaggingInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		if err == nil {
+			d.Set("tags", flattenCOSObjectTags(tagging.TagSet))
+		} else if isCOSFeatureUnavailable(err) {
+			// Read as no tags, keeping the tags in the state so that they do
+			// not show up as a change on every plan
+			log.Printf("[WARN] Skipping the tags of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+		} else {
+			return diag.FromErr(fmt.Errorf("failed getting tags of COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+		}
+	}
+
+	// The retention and legal hold are only read while they are set, or while
+	// the bucket has Object Lock and can retain the object by default
+	objectLocked := false
+	for _, k := range []string{"object_lock_mode", "object_lock_retain_until_date", "object_lock_legal_hold_status"} {
+		if _, ok := d.GetOk(k); ok {
+			objectLocked = true
+		}
+	}
+	if !objectLocked {
+		objectLocked, err = cosBucketObjectLockEnabled(ctx, s3Client, bucketName)
+		if err != nil && isCOSFeatureUnavailable(err) {
+			// Read as not locked, as it was before Object Lock was supported
+			log.Printf("[WARN] Skipping the Object Lock configuration of COS bucket (%s): %s", bucketName, err)
+		} else if err != nil {
+			return diag.FromErr(fmt.Errorf("failed getting the Object Lock configuration of COS bucket (%s): %w", bucketName, err))
+		}
+	}
+	if objectLocked {
+		retention, err := getCOSObjectRetention(ctx, s3Client, &getCOSObjectRetentionInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed getting retention of COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+		}
+		if retention != nil && retention.RetainUntilDate != nil {
+			d.Set("object_lock_mode", retention.Mode)
+			d.Set("object_lock_retain_until_date", retention.RetainUntilDate.Format(time.RFC3339))
+		} else {
+			d.Set("object_lock_mode", "")
+			d.Set("object_lock_retain_until_date", "")
+		}
+
+		legalHold, err := getCOSObjectLegalHold(ctx, s3Client, &getCOSObjectLegalHoldInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed getting legal hold of COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+		}
+		if legalHold != nil {
+			d.Set("object_lock_legal_hold_status", legalHold.Status)
+		} else {
+			d.Set("object_lock_legal_hold_status", "")
+		}
+	}
+
 	if out.LastModified != nil {
 		d.Set("last_modified", out.LastModified.Format(time.RFC1123))
 	} else {
@@ -268,6 +389,12 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		}
 		log.Printf("[INFO] Saving %d bytes from COS bucket (%s) object (%s)", bytesRead, bucketName, objectKey)
 		d.Set("body", buf.String())
+
+		// Objects uploaded before the digest was stored in their metadata
+		if contentSHA256 == "" {
+			sum := sha256.Sum256(buf.Bytes())
+			d.Set("content_sha256", hex.EncodeToString(sum[:]))
+		}
 	} else {
 		contentType := ""
 		if out.ContentType == nil {
@@ -286,7 +413,7 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
+	if d.HasChanges("content", "content_base64", "content_file", "etag", "content_sha256", "content_type", "metadata", "tags", "object_lock_mode", "object_lock_retain_until_date", "object_lock_legal_hold_status") {
 		bucketCRN := d.Get("bucket_crn").(string)
 		bucketName := strings.Split(bucketCRN, ":bucket:")[1]
 		instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
@@ -299,49 +426,40 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 			return diag.FromErr(err)
 		}
 
-		s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+		s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		var body io.ReadSeeker
+		objectKey := d.Get("key").(string)
 
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
+		uploaded := d.HasChanges("content", "content_base64", "content_file", "etag", "content_sha256", "content_type", "metadata")
+		if uploaded {
+			if err := putCOSBucketObject(ctx, d, s3Client, bucketName, objectKey); err != nil {
+				return diag.FromErr(err)
+			}
+		} else if d.HasChange("tags") {
+			// Only the tags changed, which do not need the content uploaded again
+			tags := expandCOSObjectTags(d.Get("tags").(map[string]interface{}))
+			if len(tags) > 0 {
+				_, err = s3Client.PutObjectTaggingWithContext(ctx, &s3.PutObjectTaggingInput{
+					Bucket:  aws.String(bucketName),
+					Key:     aws.String(objectKey),
+					Tagging: &s3.Tagging{TagSet: tags},
+				})
+			} else {
+				_, err = s3Client.DeleteObjectTaggingWithContext(ctx, &s3.DeleteObjectTaggingInput{
+					Bucket: aws.String(bucketName),
+					Key:    aws.String(objectKey),
+				})
 			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating tags of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
 			}
-
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
 		}
 
-		objectKey := d.Get("key").(string)
-
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
-		}
-
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		if err := putCOSBucketObjectLock(ctx, d, s3Client, bucketName, objectKey, uploaded); err != nil {
+			return diag.FromErr(err)
 		}
 
 		objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
@@ -364,12 +482,24 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	objectKey := d.Get("key").(string)
 
+	// An object under legal hold cannot be deleted
+	if d.Get("force_delete").(bool) && d.Get("object_lock_legal_hold_status").(string) == "ON" {
+		err = putCOSObjectLegalHold(ctx, s3Client, &putCOSObjectLegalHoldInput{
+			Bucket:    aws.String(bucketName),
+			Key:       aws.String(objectKey),
+			LegalHold: &cosObjectLegalHold{Status: aws.String("OFF")},
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error removing legal hold of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		}
+	}
+
 	if _, ok := d.GetOk("version_id"); ok {
 		err = deleteAllCOSObjectVersions(s3Client, bucketName, objectKey, d.Get("force_delete").(bool), false)
 	} else {
@@ -382,6 +512,308 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+func resourceIBMCOSBucketObjectCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A content_sha256 in the configuration is the digest of content_file,
+	// and is verified when the content is uploaded
+	if raw := diff.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("content_sha256").IsNull() {
+		return nil
+	}
+	if diff.HasChange("content_file") {
+		return diff.SetNewComputed("content_sha256")
+	}
+	if !diff.HasChange("content") && !diff.HasChange("content_base64") {
+		return nil
+	}
+	if !diff.NewValueKnown("content") || !diff.NewValueKnown("content_base64") {
+		return diff.SetNewComputed("content_sha256")
+	}
+
+	var content []byte
+	if v, ok := diff.GetOk("content"); ok {
+		content = []byte(v.(string))
+	} else if v, ok := diff.GetOk("content_base64"); ok {
+		contentRaw, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		content = contentRaw
+	}
+	sum := sha256.Sum256(content)
+	return diff.SetNew("content_sha256", hex.EncodeToString(sum[:]))
+}
+
+// resourceIBMCOSBucketObjectLockValidate validates that the retention of an
+// object is only extended, and that Object Lock is enabled on its bucket
+func resourceIBMCOSBucketObjectLockValidate(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.HasChange("object_lock_retain_until_date") && diff.NewValueKnown("object_lock_retain_until_date") {
+		o, n := diff.GetChange("object_lock_retain_until_date")
+		oldDate, err := time.Parse(time.RFC3339, o.(string))
+		if err == nil && oldDate.After(time.Now()) {
+			newDate, err := time.Parse(time.RFC3339, n.(string))
+			if err != nil || newDate.Before(oldDate) {
+				return fmt.Errorf("[ERROR] The object_lock_retain_until_date of the COS object can only be extended, the object is retained until %s", o.(string))
+			}
+		}
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("object_lock_mode", "object_lock_retain_until_date", "object_lock_legal_hold_status") {
+		return nil
+	}
+	// Only a retention or legal hold in the configuration, as opposed to the
+	// ones computed from the bucket defaults, requires Object Lock
+	raw := diff.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() || (raw.GetAttr("object_lock_mode").IsNull() && raw.GetAttr("object_lock_legal_hold_status").IsNull()) {
+		return nil
+	}
+	if !diff.NewValueKnown("bucket_crn") || !diff.NewValueKnown("bucket_location") {
+		return nil
+	}
+
+	bucketCRN := diff.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3Client(meta, bxSession, diff.Get("bucket_location").(string), diff.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		return err
+	}
+	objectLocked, err := cosBucketObjectLockEnabled(ctx, s3Client, bucketName)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the object lock configuration of COS bucket (%s): %s", bucketName, err)
+	}
+	if !objectLocked {
+		return fmt.Errorf("[ERROR] Object Lock is not enabled on COS bucket (%s). Set the object_lock and enable the object_versioning of the bucket", bucketName)
+	}
+	return nil
+}
+
+// cosBucketObjectLockEnabled reports whether Object Lock is enabled on the
+// bucket
+func cosBucketObjectLockEnabled(ctx context.Context, s3Client *s3.S3, bucketName string) (bool, error) {
+	configuration, err := getCOSObjectLockConfiguration(ctx, s3Client, &getCOSObjectLockConfigurationInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		return false, err
+	}
+	return configuration != nil && aws.StringValue(configuration.ObjectLockEnabled) == cosObjectLockEnabled, nil
+}
+
+// putCOSBucketObjectLock sets the configured retention and legal hold of the
+// current version of the object, which is a new version once uploaded
+func putCOSBucketObjectLock(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string, uploaded bool) error {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		return nil
+	}
+
+	if !raw.GetAttr("object_lock_mode").IsNull() && (uploaded || d.HasChanges("object_lock_mode", "object_lock_retain_until_date")) {
+		retainUntilDate, err := time.Parse(time.RFC3339, d.Get("object_lock_retain_until_date").(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing object_lock_retain_until_date: %s", err)
+		}
+		err = putCOSObjectRetention(ctx, s3Client, &putCOSObjectRetentionInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+			Retention: &cosObjectRetention{
+				Mode:            aws.String(d.Get("object_lock_mode").(string)),
+				RetainUntilDate: aws.Time(retainUntilDate),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error putting retention of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+	}
+
+	if !raw.GetAttr("object_lock_legal_hold_status").IsNull() && (uploaded || d.HasChange("object_lock_legal_hold_status")) {
+		err := putCOSObjectLegalHold(ctx, s3Client, &putCOSObjectLegalHoldInput{
+			Bucket:    aws.String(bucketName),
+			Key:       aws.String(objectKey),
+			LegalHold: &cosObjectLegalHold{Status: aws.String(d.Get("object_lock_legal_hold_status").(string))},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error putting legal hold of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+	}
+	return nil
+}
+
+// putCOSBucketObject uploads the content of the object with its content type,
+// metadata and tags
+func putCOSBucketObject(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) error {
+	var body io.ReadSeeker
+
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		body = bytes.NewReader([]byte(content))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		body = bytes.NewReader(contentRaw)
+	} else if v, ok := d.GetOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+		}
+
+		body = file
+		defer func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			}
+		}()
+	} else {
+		body = bytes.NewReader([]byte{})
+	}
+
+	contentSHA256, err := cosObjectContentSHA256(body)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading content of object (%s): %s", objectKey, err)
+	}
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("content_sha256").IsNull() {
+		if v := d.Get("content_sha256").(string); v != contentSHA256 {
+			return fmt.Errorf("[ERROR] The content_sha256 (%s) of object (%s) does not match the SHA-256 hexdigest of its content (%s)", v, objectKey, contentSHA256)
+		}
+	}
+
+	uploadInput := &s3manager.UploadInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(objectKey),
+		Metadata: expandCOSObjectMetadata(d.Get("metadata").(map[string]interface{})),
+	}
+	if v, ok := d.GetOk("content_type"); ok {
+		uploadInput.ContentType = aws.String(v.(string))
+	}
+	if tags := expandCOSObjectTags(d.Get("tags").(map[string]interface{})); len(tags) > 0 {
+		uploadInput.Tagging = aws.String(encodeCOSObjectTagging(tags))
+	}
+
+	if err := uploadCOSObject(ctx, s3Client, uploadInput, body, contentSHA256, d.Get("part_size").(int), d.Get("upload_concurrency").(int)); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+// uploadCOSObject uploads the body in a single request, or in parts of
+// partSize MiB when it is larger than a part, storing its SHA-256 hexdigest
+// in the object metadata
+func uploadCOSObject(ctx context.Context, s3Client *s3.S3, input *s3manager.UploadInput, body io.ReadSeeker, contentSHA256 string, partSize, concurrency int) error {
+	if input.Metadata == nil {
+		input.Metadata = map[string]*string{}
+	}
+	input.Metadata[cosObjectSHA256MetadataKey] = aws.String(contentSHA256)
+	input.Body = body
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = int64(partSize) * 1024 * 1024
+		u.Concurrency = concurrency
+	})
+	_, err := uploader.UploadWithContext(ctx, input)
+	return err
+}
+
+// cosObjectContentSHA256 returns the SHA-256 hexdigest of the body, and
+// rewinds it to be uploaded
+func cosObjectContentSHA256(body io.ReadSeeker) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, body); err != nil {
+		return "", err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func validateCOSObjectMetadata(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf("%q: metadata key %q must be lowercase", k, key))
+		}
+		if key == cosObjectSHA256MetadataKey {
+			errors = append(errors, fmt.Errorf("%q: metadata key %q is reserved for the content digest", k, key))
+		}
+	}
+	return
+}
+
+func expandCOSObjectMetadata(m map[string]interface{}) map[string]*string {
+	metadata := make(map[string]*string, len(m))
+	for key, value := range m {
+		metadata[key] = aws.String(value.(string))
+	}
+	return metadata
+}
+
+// flattenCOSObjectMetadata returns the content digest and the user metadata of
+// the object. COS returns the metadata keys in canonical header format, so
+// they are lowercased as the configured keys must be.
+func flattenCOSObjectMetadata(metadata map[string]*string) (string, map[string]interface{}) {
+	contentSHA256 := ""
+	m := make(map[string]interface{}, len(metadata))
+	for key, value := range metadata {
+		key = strings.ToLower(key)
+		if key == cosObjectSHA256MetadataKey {
+			contentSHA256 = aws.StringValue(value)
+			continue
+		}
+		m[key] = aws.StringValue(value)
+	}
+	return contentSHA256, m
+}
+
+func expandCOSObjectTags(m map[string]interface{}) []*s3.Tag {
+	tags := make([]*s3.Tag, 0, len(m))
+	for key, value := range m {
+		tags = append(tags, &s3.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value.(string)),
+		})
+	}
+	return tags
+}
+
+func flattenCOSObjectTags(tags []*s3.Tag) map[string]interface{} {
+	m := make(map[string]interface{}, len(tags))
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return m
+}
+
+// isCOSFeatureUnavailable returns whether the error is returned when e.g. the
+// tags of an object or the Object Lock configuration of a bucket cannot be
+// read, because the user is not allowed to or the endpoint does not implement
+// the feature
+func isCOSFeatureUnavailable(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case "AccessDenied", "NotImplemented":
+			return true
+		}
+	}
+	return false
+}
+
+// encodeCOSObjectTagging returns the tags as the query string of the tagging
+// header of an upload
+func encodeCOSObjectTagging(tags []*s3.Tag) string {
+	values := url.Values{}
+	for _, tag := range tags {
+		values.Set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+	return values.Encode()
+}
+
 func getCosEndpoint(bucketLocation string, endpointType string) string {
 	if bucketLocation != "" {
 		switch endpointType {
@@ -398,11 +830,11 @@ func getCosEndpoint(bucketLocation string, endpointType string) string {
 	return ""
 }
 
-func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
+func getS3Client(meta interface{}, bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
 	var s3Conf *aws.Config
 
 	apiEndpoint := getCosEndpoint(bucketLocation, endpointType)
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 	if apiEndpoint == "" {
 		return nil, fmt.Errorf("the endpoint doesn't exists for given location %s and endpoint type %s", bucketLocation, endpointType)
 	}
@@ -68,6 +68,68 @@ func TestAccIBMCOSBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCOSBucketObject_metadata(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectFile := "../../test-fixtures/app1.zip"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_metadata(name, instanceCRN, objectFile, "1.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "content_sha256"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_type", "application/zip"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "metadata.version", "1.0"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "tags.%", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "tags.version", "1.0"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketObjectConfig_metadata(name, instanceCRN, objectFile, "1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "metadata.version", "1.1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "tags.version", "1.1"),
+				),
+			},
+		},
+	})
+}
+
+// The retention of an object in COMPLIANCE mode cannot be removed, so only the
+// legal hold is tested, which force_delete removes before deleting the object
+func TestAccIBMCOSBucketObject_legalHold(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectBody := "Acceptance Testing"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_legalHold(name, instanceCRN, objectBody, "ON"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "ON"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketObjectConfig_legalHold(name, instanceCRN, objectBody, "OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "OFF"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketObjectConfig_legalHold(name, instanceCRN, objectBody, "ON"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "ON"),
+				),
+			},
+		},
+	})
+}
+
 func testAccIBMCOSBucketObjectConfig_plaintext(name string, instanceCRN string, objectBody string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
@@ -115,3 +177,50 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 			content_file	  = "%[3]s"
 		}`, name, instanceCRN, objectFile)
 }
+
+func testAccIBMCOSBucketObjectConfig_metadata(name string, instanceCRN string, objectFile string, version string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn         = ibm_cos_bucket.testacc.crn
+			bucket_location    = ibm_cos_bucket.testacc.region_location
+			key                = "%[1]s.zip"
+			content_file       = "%[3]s"
+			content_sha256     = filesha256("%[3]s")
+			content_type       = "application/zip"
+			part_size          = 5
+			upload_concurrency = 2
+			metadata = {
+				version = "%[4]s"
+			}
+			tags = {
+				version = "%[4]s"
+			}
+		}`, name, instanceCRN, objectFile, version)
+}
+
+func testAccIBMCOSBucketObjectConfig_legalHold(name string, instanceCRN string, objectBody string, legalHoldStatus string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+			object_versioning {
+				enable = true
+			}
+			object_lock {}
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn                    = ibm_cos_bucket.testacc.crn
+			bucket_location               = ibm_cos_bucket.testacc.region_location
+			key                           = "%[1]s.txt"
+			content                       = "%[3]s"
+			object_lock_legal_hold_status = "%[4]s"
+		}`, name, instanceCRN, objectBody, legalHoldStatus)
+}
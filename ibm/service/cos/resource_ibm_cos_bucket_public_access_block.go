@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketPublicAccessBlock() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketPublicAccessBlockCreate,
+		Read:     resourceIBMCOSBucketPublicAccessBlockRead,
+		Update:   resourceIBMCOSBucketPublicAccessBlockCreate,
+		Delete:   resourceIBMCOSBucketPublicAccessBlockDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"block_public_acls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Reject requests that set a public ACL on the bucket or its objects",
+			},
+			"ignore_public_acls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Ignore the public ACLs of the bucket and its objects",
+			},
+		},
+	}
+}
+
+func resourceIBMCOSBucketPublicAccessBlockCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	putPublicAccessBlockInput := &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+			BlockPublicAcls:  aws.Bool(d.Get("block_public_acls").(bool)),
+			IgnorePublicAcls: aws.Bool(d.Get("ignore_public_acls").(bool)),
+		},
+	}
+	_, err = s3Client.PutPublicAccessBlock(putPublicAccessBlockInput)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error setting the public access block on COS bucket %s, %v", bucketName, err)
+	}
+
+	d.SetId(bucketConfigurationID(instanceCRN, bucketName, bucketLocation, endpointType))
+
+	return resourceIBMCOSBucketPublicAccessBlockRead(d, meta)
+}
+
+func resourceIBMCOSBucketPublicAccessBlockRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	getPublicAccessBlockInput := &s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	}
+	publicAccessBlock, err := s3Client.GetPublicAccessBlock(getPublicAccessBlockInput)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "NoSuchPublicAccessBlockConfiguration" || aerr.Code() == s3.ErrCodeNoSuchBucket) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting the public access block of COS bucket %s, %v", bucketName, err)
+	}
+
+	if configuration := publicAccessBlock.PublicAccessBlockConfiguration; configuration != nil {
+		d.Set("block_public_acls", aws.BoolValue(configuration.BlockPublicAcls))
+		d.Set("ignore_public_acls", aws.BoolValue(configuration.IgnorePublicAcls))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketPublicAccessBlockDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	deletePublicAccessBlockInput := &s3.DeletePublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeletePublicAccessBlock(deletePublicAccessBlockInput)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting the public access block of COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
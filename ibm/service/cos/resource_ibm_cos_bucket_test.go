@@ -4,8 +4,10 @@
 package cos_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -14,6 +16,7 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/migrate"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
 
 	"github.com/IBM/ibm-cos-sdk-go/aws"
@@ -50,6 +53,7 @@ var regionLocationRegex = regexp.MustCompile("^[a-z]{2}-[a-z]{2,5}[0-9]?-[a-z]{4
 var crossRegionLocationRegex = regexp.MustCompile("^[a-z]{2}-[a-z]{4,8}$")
 
 func TestAccIBMCosBucket_Basic(t *testing.T) {
+	defer acc.Replay(t)()
 
 	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
 	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
@@ -576,6 +580,37 @@ func TestAccIBMCosBucket_Object_Versioning(t *testing.T) {
 	})
 }
 
+func TestAccIBMCosBucket_Object_Lock(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-east"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegionType, bucketRegion, bucketClass, false),
+				ExpectError: regexp.MustCompile("Object Lock requires object versioning"),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegionType, bucketRegion, bucketClass, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_versioning.0.enable", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock.0.default_retention.0.mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock.0.default_retention.0.days", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Hard_Quota(t *testing.T) {
 
 	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
@@ -1748,6 +1783,38 @@ func testAccCheckIBMCosBucket_object_versioning(cosServiceName string, bucketNam
 	`, cosServiceName, bucketName, region, storageClass)
 }
 
+func testAccCheckIBMCosBucket_object_lock(cosServiceName string, bucketName string, regiontype string, region string, storageClass string, enable bool) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		region_location       = "%s"
+		storage_class         = "%s"
+		object_versioning {
+			enable  = %t
+		}
+		object_lock {
+			default_retention {
+				mode = "COMPLIANCE"
+				days = 1
+			}
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, enable)
+}
+
 func testAccCheckIBMCosBucket_hard_quota(cosServiceName string, bucketName string, regiontype string, region string, storageClass string, hardQuota int) string {
 
 	return fmt.Sprintf(`
@@ -2180,3 +2247,27 @@ func TestCrossRegionLocationRegex(t *testing.T) {
 		}
 	}
 }
+
+func TestIBMCOSBucketStateUpgradeV0(t *testing.T) {
+	cases := []struct {
+		state, want map[string]interface{}
+	}{
+		{
+			map[string]interface{}{"id": "crn:v1:bluemix:public:cloud-object-storage:global:a/1:2:bucket:b:meta:rl:us-south"},
+			map[string]interface{}{"id": "crn:v1:bluemix:public:cloud-object-storage:global:a/1:2:bucket:b:meta:rl:us-south", "endpoint_type": "public", "force_delete": true},
+		},
+		{
+			map[string]interface{}{"id": "crn:v1:bluemix:public:cloud-object-storage:global:a/1:2:bucket:b:meta:rl:us-south:private", "force_delete": false},
+			map[string]interface{}{"id": "crn:v1:bluemix:public:cloud-object-storage:global:a/1:2:bucket:b:meta:rl:us-south:private", "endpoint_type": "private", "force_delete": false},
+		},
+	}
+	for _, c := range cases {
+		got, err := migrate.Apply(context.Background(), cos.ResourceIBMCOSBucket(), 0, c.state, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("expected %v, got %v", c.want, got)
+		}
+	}
+}
@@ -0,0 +1,394 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketWebsiteConfigurationCreate,
+		Read:     resourceIBMCOSBucketWebsiteConfigurationRead,
+		Update:   resourceIBMCOSBucketWebsiteConfigurationCreate,
+		Delete:   resourceIBMCOSBucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"index_document": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"index_document", "redirect_all_requests_to"},
+				Description:  "The object returned for requests to the root of the website or to a folder",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suffix": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The suffix appended to requests for a folder, for example index.html",
+						},
+					},
+				},
+			},
+			"error_document": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Description:   "The object returned when an error occurs",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The key of the object, for example error.html",
+						},
+					},
+				},
+			},
+			"redirect_all_requests_to": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ExactlyOneOf:  []string{"index_document", "redirect_all_requests_to"},
+				ConflictsWith: []string{"error_document", "routing_rule"},
+				Description:   "Redirects all the requests to the website to another host",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The host the requests are redirected to",
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+							Description:  "The protocol of the redirects: http, https. Defaults to the protocol of the request",
+						},
+					},
+				},
+			},
+			"routing_rule": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Description:   "Rules that redirect requests matching a condition",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The condition the request must match for the redirect to apply",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"http_error_code_returned_equals": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The HTTP error code the request must return, for example 404",
+									},
+									"key_prefix_equals": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The prefix the key of the request must start with",
+									},
+								},
+							},
+						},
+						"redirect": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: "The redirect of the requests",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The host the requests are redirected to",
+									},
+									"http_redirect_code": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The HTTP redirect code of the response, for example 301",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+										Description:  "The protocol of the redirects: http, https",
+									},
+									"replace_key_prefix_with": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The prefix that replaces key_prefix_equals in the redirect",
+									},
+									"replace_key_with": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The key the requests are redirected to",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"website_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public endpoint of the website",
+			},
+		},
+	}
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	websiteConfiguration := expandCOSBucketWebsiteConfiguration(d)
+	putBucketWebsiteInput := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucketName),
+		WebsiteConfiguration: websiteConfiguration,
+	}
+
+	_, err = s3Client.PutBucketWebsite(putBucketWebsiteInput)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error setting the website configuration on COS bucket %s, %v", bucketName, err)
+	}
+
+	d.SetId(bucketConfigurationID(instanceCRN, bucketName, bucketLocation, endpointType))
+
+	return resourceIBMCOSBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	getBucketWebsiteInput := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	}
+	website, err := s3Client.GetBucketWebsite(getBucketWebsiteInput)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "NoSuchWebsiteConfiguration" || aerr.Code() == s3.ErrCodeNoSuchBucket) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting the website configuration of COS bucket %s, %v", bucketName, err)
+	}
+
+	indexDocument := []map[string]interface{}{}
+	if website.IndexDocument != nil {
+		indexDocument = append(indexDocument, map[string]interface{}{
+			"suffix": aws.StringValue(website.IndexDocument.Suffix),
+		})
+	}
+	d.Set("index_document", indexDocument)
+
+	errorDocument := []map[string]interface{}{}
+	if website.ErrorDocument != nil {
+		errorDocument = append(errorDocument, map[string]interface{}{
+			"key": aws.StringValue(website.ErrorDocument.Key),
+		})
+	}
+	d.Set("error_document", errorDocument)
+
+	redirectAllRequestsTo := []map[string]interface{}{}
+	if website.RedirectAllRequestsTo != nil {
+		redirectAllRequestsTo = append(redirectAllRequestsTo, map[string]interface{}{
+			"host_name": aws.StringValue(website.RedirectAllRequestsTo.HostName),
+			"protocol":  aws.StringValue(website.RedirectAllRequestsTo.Protocol),
+		})
+	}
+	d.Set("redirect_all_requests_to", redirectAllRequestsTo)
+
+	routingRules := make([]map[string]interface{}, 0, len(website.RoutingRules))
+	for _, rule := range website.RoutingRules {
+		routingRule := map[string]interface{}{}
+		if rule.Condition != nil {
+			routingRule["condition"] = []map[string]interface{}{{
+				"http_error_code_returned_equals": aws.StringValue(rule.Condition.HttpErrorCodeReturnedEquals),
+				"key_prefix_equals":               aws.StringValue(rule.Condition.KeyPrefixEquals),
+			}}
+		}
+		if rule.Redirect != nil {
+			routingRule["redirect"] = []map[string]interface{}{{
+				"host_name":               aws.StringValue(rule.Redirect.HostName),
+				"http_redirect_code":      aws.StringValue(rule.Redirect.HttpRedirectCode),
+				"protocol":                aws.StringValue(rule.Redirect.Protocol),
+				"replace_key_prefix_with": aws.StringValue(rule.Redirect.ReplaceKeyPrefixWith),
+				"replace_key_with":        aws.StringValue(rule.Redirect.ReplaceKeyWith),
+			}}
+		}
+		routingRules = append(routingRules, routingRule)
+	}
+	d.Set("routing_rule", routingRules)
+
+	d.Set("website_endpoint", fmt.Sprintf("%s.s3-web.%s.cloud-object-storage.appdomain.cloud", bucketName, bucketLocation))
+	return nil
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	deleteBucketWebsiteInput := &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeleteBucketWebsite(deleteBucketWebsiteInput)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting the website configuration of COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
+
+func expandCOSBucketWebsiteConfiguration(d *schema.ResourceData) *s3.WebsiteConfiguration {
+	websiteConfiguration := &s3.WebsiteConfiguration{}
+
+	if v, ok := d.GetOk("index_document"); ok && v.([]interface{})[0] != nil {
+		indexDocument := v.([]interface{})[0].(map[string]interface{})
+		websiteConfiguration.IndexDocument = &s3.IndexDocument{
+			Suffix: aws.String(indexDocument["suffix"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("error_document"); ok && v.([]interface{})[0] != nil {
+		errorDocument := v.([]interface{})[0].(map[string]interface{})
+		websiteConfiguration.ErrorDocument = &s3.ErrorDocument{
+			Key: aws.String(errorDocument["key"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("redirect_all_requests_to"); ok && v.([]interface{})[0] != nil {
+		redirect := v.([]interface{})[0].(map[string]interface{})
+		websiteConfiguration.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+			HostName: aws.String(redirect["host_name"].(string)),
+		}
+		if protocol := redirect["protocol"].(string); protocol != "" {
+			websiteConfiguration.RedirectAllRequestsTo.Protocol = aws.String(protocol)
+		}
+	}
+
+	for _, r := range d.Get("routing_rule").([]interface{}) {
+		rule, _ := r.(map[string]interface{})
+		routingRule := &s3.RoutingRule{Redirect: &s3.Redirect{}}
+		if c, ok := rule["condition"].([]interface{}); ok && len(c) > 0 && c[0] != nil {
+			condition := c[0].(map[string]interface{})
+			routingRule.Condition = &s3.Condition{
+				HttpErrorCodeReturnedEquals: optionalCOSString(condition["http_error_code_returned_equals"]),
+				KeyPrefixEquals:             optionalCOSString(condition["key_prefix_equals"]),
+			}
+		}
+		if r, ok := rule["redirect"].([]interface{}); ok && len(r) > 0 && r[0] != nil {
+			redirect := r[0].(map[string]interface{})
+			routingRule.Redirect = &s3.Redirect{
+				HostName:             optionalCOSString(redirect["host_name"]),
+				HttpRedirectCode:     optionalCOSString(redirect["http_redirect_code"]),
+				Protocol:             optionalCOSString(redirect["protocol"]),
+				ReplaceKeyPrefixWith: optionalCOSString(redirect["replace_key_prefix_with"]),
+				ReplaceKeyWith:       optionalCOSString(redirect["replace_key_with"]),
+			}
+		}
+		websiteConfiguration.RoutingRules = append(websiteConfiguration.RoutingRules, routingRule)
+	}
+
+	return websiteConfiguration
+}
+
+// optionalCOSString returns the string, or nil for an empty string so that it
+// is left out of the request
+func optionalCOSString(v interface{}) *string {
+	if s, _ := v.(string); s != "" {
+		return aws.String(s)
+	}
+	return nil
+}
+
+// bucketConfigurationID returns the ID of the resources that configure a
+// bucket, which contains everything needed to get the bucket via the s3 api
+func bucketConfigurationID(instanceCRN, bucketName, bucketLocation, endpointType string) string {
+	return fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+}
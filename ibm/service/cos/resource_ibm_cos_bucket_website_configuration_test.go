@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucketWebsiteConfiguration_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-website-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_cos_bucket_website_configuration.website"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucketWebsiteConfiguration(serviceName, bucketName, "index.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "index_document.0.suffix", "index.html"),
+					resource.TestCheckResourceAttr(name, "error_document.0.key", "error.html"),
+					resource.TestCheckResourceAttr(name, "routing_rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "website_endpoint", fmt.Sprintf("%s.s3-web.us-south.cloud-object-storage.appdomain.cloud", bucketName)),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucketWebsiteConfiguration(serviceName, bucketName, "home.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "index_document.0.suffix", "home.html"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucketWebsiteConfiguration(serviceName string, bucketName string, indexSuffix string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default=true
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+	}
+
+	resource "ibm_cos_bucket_website_configuration" "website" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		index_document {
+			suffix = "%s"
+		}
+		error_document {
+			key = "error.html"
+		}
+		routing_rule {
+			condition {
+				key_prefix_equals = "docs/"
+			}
+			redirect {
+				replace_key_prefix_with = "documents/"
+			}
+		}
+	}
+	`, serviceName, bucketName, indexSuffix)
+}
@@ -169,7 +169,7 @@ func resourceIBMCOSBucketReplicationConfigurationCreate(d *schema.ResourceData,
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	var rules []*s3.ReplicationRule
 
 	replication, ok := d.GetOk("replication_rule")
@@ -210,7 +210,7 @@ func resourceIBMCOSBucketReplicationConfigurationUpdate(d *schema.ResourceData,
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -259,7 +259,7 @@ func resourceIBMCOSBucketReplicationConfigurationRead(d *schema.ResourceData, me
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -295,7 +295,7 @@ func resourceIBMCOSBucketReplicationConfigurationDelete(d *schema.ResourceData,
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -351,11 +351,11 @@ func getCosEndpointType(bucketLocation string, endpointType string) string {
 	return ""
 }
 
-func getS3ClientSession(bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
+func getS3ClientSession(meta interface{}, bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
 	var s3Conf *aws.Config
 
 	apiEndpoint := getCosEndpointType(bucketLocation, endpointType)
-	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	apiEndpoint = meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_COS_ENDPOINT", apiEndpoint)
 	if apiEndpoint == "" {
 		return nil, fmt.Errorf("the endpoint doesn't exists for given location %s and endpoint type %s", bucketLocation, endpointType)
 	}
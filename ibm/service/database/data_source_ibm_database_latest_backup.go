@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func DataSourceIBMDatabaseLatestBackup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceIBMDatabaseLatestBackupRead,
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the deployment the backup relates to.",
+			},
+			"before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only consider backups created before this RFC 3339 timestamp. Defaults to now.",
+			},
+			"backup_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{clouddatabasesv5.BackupTypeOnDemandConst, clouddatabasesv5.BackupTypeScheduledConst}, false),
+				Description:  "Only consider backups of this type, on_demand or scheduled.",
+			},
+			"restorable_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Only consider backups that can be used to restore an instance.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of this backup.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of backup.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of this backup.",
+			},
+			"is_downloadable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is this backup available to download?.",
+			},
+			"is_restorable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Can this backup be used to restore an instance?.",
+			},
+			"download_link": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URI which is currently available for file downloading.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time when this backup was created.",
+			},
+		},
+	}
+}
+
+func DataSourceIBMDatabaseLatestBackupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	before := time.Now()
+	if v, ok := d.GetOk("before"); ok {
+		// Validated by IsRFC3339Time
+		before, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	listDeploymentBackupsOptions := &clouddatabasesv5.ListDeploymentBackupsOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+	backups, response, err := cloudDatabasesClient.ListDeploymentBackupsWithContext(context, listDeploymentBackupsOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("ListDeploymentBackups").WithResource("ibm_database_latest_backup", deploymentID).Diagnostics()
+	}
+
+	backup := latestDatabaseBackup(backups.Backups, d.Get("backup_type").(string), before, d.Get("restorable_only").(bool))
+	if backup == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] No backups of database (%s) found before %s", deploymentID, before.UTC().Format(time.RFC3339)))
+	}
+
+	d.SetId(*backup.ID)
+	d.Set("backup_id", backup.ID)
+	d.Set("type", backup.Type)
+	d.Set("status", backup.Status)
+	d.Set("is_downloadable", backup.IsDownloadable)
+	d.Set("is_restorable", backup.IsRestorable)
+	d.Set("download_link", backup.DownloadLink)
+	d.Set("created_at", flex.DateTimeToString(backup.CreatedAt))
+	return nil
+}
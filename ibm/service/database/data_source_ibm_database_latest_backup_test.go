@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMDatabaseLatestBackupDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMDatabaseLatestBackupDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_database_latest_backup.latest", "backup_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_database_latest_backup.latest", "created_at"),
+					resource.TestCheckResourceAttr("data.ibm_database_latest_backup.latest", "is_restorable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseLatestBackupDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_database_latest_backup" "latest" {
+			deployment_id = "%[1]s"
+		}
+	`, acc.IcdDbDeploymentId)
+}
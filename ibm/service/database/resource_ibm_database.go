@@ -54,45 +54,6 @@ type userChange struct {
 	Old, New map[string]interface{}
 }
 
-func retry(f func() error) (err error) {
-	attempts := 3
-
-	for i := 0; ; i++ {
-		sleep := time.Duration(10*i*i) * time.Second
-		time.Sleep(sleep)
-
-		err = f()
-		if err == nil {
-			return nil
-		}
-
-		if i == attempts {
-			return err
-		}
-
-		log.Println("retrying after error:", err)
-	}
-}
-func retryTask(f func() (icdv4.Task, error)) (task icdv4.Task, err error) {
-	attempts := 3
-
-	for i := 0; ; i++ {
-		sleep := time.Duration(5*i) * time.Second
-		time.Sleep(sleep)
-
-		task, err = f()
-		if err == nil {
-			return task, nil
-		}
-
-		if i == attempts {
-			return task, nil
-		}
-
-		log.Println("retrying after error:", err)
-	}
-}
-
 func ResourceIBMDatabaseInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMDatabaseInstanceCreate,
@@ -103,6 +64,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
+			resourceIBMDatabaseInstanceConflictsDiff,
 			checkV5Groups),
 
 		Importer: &schema.ResourceImporter{},
@@ -311,6 +273,13 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_database", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 			"point_in_time_recovery_deployment_id": {
 				Description:      "The CRN of source instance",
 				Type:             schema.TypeString,
@@ -440,9 +409,32 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+			"allowlist": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"whitelist"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Description:  "Allowlist IP address in CIDR notation",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateCIDR,
+						},
+						"description": {
+							Description:  "Unique allowlist description",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+					},
+				},
+			},
 			"whitelist": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"allowlist"},
+				Deprecated:    "whitelist is deprecated and support will be removed. Use allowlist instead",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address": {
@@ -460,6 +452,11 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+			"allowlist_managed_externally": {
+				Description: "Whether allowlist entries are managed by ibm_database_allowlist resources, in which case only the entries of allowlist or whitelist are read",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 			"group": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -1091,6 +1088,11 @@ func checkMbValue(name string, limits MbLimit, divider int, diff *schema.Resourc
 }
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	err = flex.ResourceDefaultTagsCustomizeDiff(diff, meta)
+	if err != nil {
+		return err
+	}
+
 	err = flex.ResourceTagsCustomizeDiff(diff)
 	if err != nil {
 		return err
@@ -1186,12 +1188,12 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving database service offering: %s", err))
+		return flex.NewAPIError(err, nil).WithOperation("FindByName").WithResource("ibm_database", d.Id()).Diagnostics()
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
+		return flex.NewAPIError(err, nil).WithOperation("GetServicePlanID").WithResource("ibm_database", d.Id()).Diagnostics()
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
@@ -1457,7 +1459,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if wl, ok := d.GetOk("whitelist"); ok {
+	if wl, ok := d.GetOk(databaseAllowlistAttribute(d)); ok {
 		whitelist := flex.ExpandWhitelist(wl.(*schema.Set))
 		for _, wlEntry := range whitelist {
 			whitelistReq := icdv4.WhitelistReq{
@@ -1468,7 +1470,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 			}
 			task, err := icdClient.Whitelists().CreateWhitelist(icdId, whitelistReq)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error updating database whitelist entry: %s", err))
+				return flex.NewAPIError(err, nil).WithOperation("CreateWhitelist").WithResource("ibm_database", d.Id()).Diagnostics()
 			}
 			_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
@@ -1486,7 +1488,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		params.Autoscaling.CPU = &cpuBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database cpu auto_scaling group: %s", err))
+			return flex.NewAPIError(err, nil).WithOperation("SetAutoScaling").WithResource("ibm_database", d.Id()).Diagnostics()
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -1504,7 +1506,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		params.Autoscaling.Disk = &diskBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database disk auto_scaling group: %s", err))
+			return flex.NewAPIError(err, nil).WithOperation("SetAutoScaling").WithResource("ibm_database", d.Id()).Diagnostics()
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -1522,7 +1524,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		params.Autoscaling.Memory = &memoryBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database memory auto_scaling group: %s", err))
+			return flex.NewAPIError(err, nil).WithOperation("SetAutoScaling").WithResource("ibm_database", d.Id()).Diagnostics()
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -1594,7 +1596,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response))
+		return flex.NewAPIError(err, response).WithOperation("GetResourceInstance").WithResource("ibm_database", d.Id()).Diagnostics()
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -1648,14 +1650,14 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 
 	serviceOff, err := rsCatRepo.GetServiceName(*instance.ResourceID)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
+		return flex.NewAPIError(err, nil).WithOperation("GetServiceName").WithResource("ibm_database", d.Id()).Diagnostics()
 	}
 
 	d.Set("service", serviceOff)
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
+		return flex.NewAPIError(err, nil).WithOperation("GetServicePlanName").WithResource("ibm_database", d.Id()).Diagnostics()
 	}
 	d.Set("plan", servicePlan)
 
@@ -1690,7 +1692,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 
 	groupList, err := icdClient.Groups().GetGroups(icdId)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database groups: %s", err))
+		return flex.NewAPIError(err, nil).WithOperation("GetGroups").WithResource("ibm_database", d.Id()).Diagnostics()
 	}
 	d.Set("groups", flex.FlattenIcdGroups(groupList))
 	d.Set("node_count", groupList.Groups[0].Members.AllocationCount)
@@ -1706,15 +1708,29 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 
 	autoSclaingGroup, err := icdClient.AutoScaling().GetAutoScaling(icdId, "member")
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database autoscaling groups: %s", err))
+		return flex.NewAPIError(err, nil).WithOperation("GetAutoScaling").WithResource("ibm_database", d.Id()).Diagnostics()
 	}
 	d.Set("auto_scaling", flattenICDAutoScalingGroup(autoSclaingGroup))
 
 	whitelist, err := icdClient.Whitelists().GetWhitelist(icdId)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database whitelist: %s", err))
+		return flex.NewAPIError(err, nil).WithOperation("GetWhitelist").WithResource("ibm_database", d.Id()).Diagnostics()
+	}
+	attribute := databaseAllowlistAttribute(d)
+	entries := flex.FlattenWhitelist(whitelist)
+	// The entries of ibm_database_allowlist resources are left out, only the
+	// addresses in the state are refreshed
+	if d.Get("allowlist_managed_externally").(bool) {
+		addresses := databaseAllowlistAddresses(d.Get(attribute).(*schema.Set))
+		managed := make([]map[string]interface{}, 0, len(addresses))
+		for _, entry := range entries {
+			if addresses[entry["address"].(string)] {
+				managed = append(managed, entry)
+			}
+		}
+		entries = managed
 	}
-	d.Set("whitelist", flex.FlattenWhitelist(whitelist))
+	d.Set(attribute, entries)
 
 	var connectionStrings []flex.CsEntry
 	//ICD does not implement a GetUsers API. Users populated from tf configuration.
@@ -1780,7 +1796,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	if update {
 		_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
+			return flex.NewAPIError(err, response).WithOperation("UpdateResourceInstance").WithResource("ibm_database", d.Id()).Diagnostics()
 		}
 
 		_, err = waitForDatabaseInstanceUpdate(d, meta)
@@ -1878,7 +1894,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 
 		task, err := icdClient.Groups().UpdateGroup(icdId, "member", params)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database scaling group: %s", err))
+			return flex.NewAPIError(err, nil).WithOperation("UpdateGroup").WithResource("ibm_database", d.Id()).Diagnostics()
 		}
 
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
@@ -1974,7 +1990,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		params.Autoscaling.CPU = &cpuBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database cpu auto_scaling group: %s", err))
+			return flex.NewAPIError(err, nil).WithOperation("SetAutoScaling").WithResource("ibm_database", d.Id()).Diagnostics()
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -1993,7 +2009,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		params.Autoscaling.Disk = &diskBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database disk auto_scaling  group: %s", err))
+			return flex.NewAPIError(err, nil).WithOperation("SetAutoScaling").WithResource("ibm_database", d.Id()).Diagnostics()
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -2012,7 +2028,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		params.Autoscaling.Memory = &memoryBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database memory auto_scaling  group: %s", err))
+			return flex.NewAPIError(err, nil).WithOperation("SetAutoScaling").WithResource("ibm_database", d.Id()).Diagnostics()
 		}
 		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -2048,16 +2064,8 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("whitelist") {
-		oldList, newList := d.GetChange("whitelist")
-		if oldList == nil {
-			oldList = new(schema.Set)
-		}
-		if newList == nil {
-			newList = new(schema.Set)
-		}
-		os := oldList.(*schema.Set)
-		ns := newList.(*schema.Set)
+	if d.HasChange("allowlist") || d.HasChange("whitelist") {
+		os, ns := databaseAllowlistChange(d)
 		remove := os.Difference(ns).List()
 		add := ns.Difference(os).List()
 
@@ -2095,7 +2103,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				ipAddress := wlEntry.Address
 				task, err := icdClient.Whitelists().DeleteWhitelist(icdId, ipAddress)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting database whitelist entry: %s", err))
+					return flex.NewAPIError(err, nil).WithOperation("DeleteWhitelist").WithResource("ibm_database", d.Id()).Diagnostics()
 				}
 				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -2253,7 +2261,7 @@ func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.I
 	_, err := icdClient.Groups().UpdateGroup(icdId, "member", params)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating database scaling group: %s", err)
+		return flex.NewAPIError(err, nil).WithOperation("UpdateGroup").WithResource("ibm_database", d.Id())
 	}
 
 	// ScaleOut is handled with an ICD API call, however, the check is is on the instance status
@@ -2276,7 +2284,7 @@ func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint st
 	icdId := d.Id()
 	connection, err := icdClient.Connections().GetConnection(icdId, userName, connectionEndpoint)
 	if err != nil {
-		return csEntry, fmt.Errorf("[ERROR] Error getting database user connection string via ICD API: %s", err)
+		return csEntry, flex.NewAPIError(err, nil).WithOperation("GetConnection").WithResource("ibm_database", d.Id())
 	}
 
 	service := d.Get("service")
@@ -2395,7 +2403,7 @@ func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{})
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("[ERROR] Error getting database: %s %s", err, response)
+		return false, flex.NewAPIError(err, response).WithOperation("GetResourceInstance").WithResource("ibm_database", d.Id())
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, databaseInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -2406,6 +2414,122 @@ func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{})
 	return *instance.ID == instanceID, nil
 }
 
+// databaseAllowlistAttribute returns the attribute that holds the allowlist
+// of the database: allowlist once it is used, the deprecated whitelist
+// otherwise, e.g. after an import
+func databaseAllowlistAttribute(d *schema.ResourceData) string {
+	if _, ok := d.GetOk("allowlist"); ok {
+		return "allowlist"
+	}
+	return "whitelist"
+}
+
+// databaseAllowlistAddresses returns the addresses of allowlist entries
+func databaseAllowlistAddresses(entries *schema.Set) map[string]bool {
+	addresses := make(map[string]bool, entries.Len())
+	for _, raw := range entries.List() {
+		if address := raw.(map[string]interface{})["address"].(string); address != "" {
+			addresses[address] = true
+		}
+	}
+	return addresses
+}
+
+// databaseAllowlistChange returns the old and new allowlist entries, whichever
+// of allowlist and whitelist they are set in, so that moving the entries from
+// one attribute to the other does not change the allowlist of the database
+func databaseAllowlistChange(d interface {
+	GetChange(string) (interface{}, interface{})
+}) (*schema.Set, *schema.Set) {
+	oldAllowlist, newAllowlist := d.GetChange("allowlist")
+	oldWhitelist, newWhitelist := d.GetChange("whitelist")
+	return oldAllowlist.(*schema.Set).Union(oldWhitelist.(*schema.Set)),
+		newAllowlist.(*schema.Set).Union(newWhitelist.(*schema.Set))
+}
+
+// resourceIBMDatabaseInstanceConflictsDiff fails the plan when the allowlist,
+// the configuration or the users of the resource add an address, a parameter
+// or a user that already exists in the database, e.g. because it is managed
+// by an ibm_database_allowlist, ibm_database_configuration or
+// ibm_database_user resource. Users are not read, so all of them are added
+// after an import and the check is skipped while the users are empty in the
+// state.
+func resourceIBMDatabaseInstanceConflictsDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	instanceID := diff.Id()
+	if instanceID == "" {
+		return nil
+	}
+
+	if diff.HasChange("allowlist") || diff.HasChange("whitelist") {
+		os, ns := databaseAllowlistChange(diff)
+		oldAddresses := databaseAllowlistAddresses(os)
+		var allowlist *clouddatabasesv5.GetAllowlistResponse
+		for address := range databaseAllowlistAddresses(ns) {
+			if oldAddresses[address] {
+				continue
+			}
+			if allowlist == nil {
+				cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+				if err != nil {
+					return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+				}
+				getAllowlistOptions := &clouddatabasesv5.GetAllowlistOptions{
+					ID: core.StringPtr(instanceID),
+				}
+				var response *core.DetailedResponse
+				allowlist, response, err = cloudDatabasesClient.GetAllowlistWithContext(context, getAllowlistOptions)
+				if err != nil {
+					return flex.NewAPIError(err, response).WithOperation("GetAllowlist").WithResource("ibm_database", instanceID)
+				}
+			}
+			for _, entry := range allowlist.IPAddresses {
+				if entry.Address != nil && *entry.Address == address {
+					return databaseAllowlistConflictError(address, instanceID)
+				}
+			}
+		}
+	}
+
+	if diff.HasChange("configuration") && diff.NewValueKnown("configuration") {
+		o, n := diff.GetChange("configuration")
+		if err := databaseConfigurationConflicts(meta, instanceID, o.(string), n.(string)); err != nil {
+			return err
+		}
+	}
+
+	if diff.HasChange("users") {
+		oldUsers, newUsers := diff.GetChange("users")
+		userKey := func(raw map[string]interface{}) string {
+			return strings.Join([]string{raw["type"].(string), raw["name"].(string)}, databaseIDSeparator)
+		}
+		oldKeys := make(map[string]bool)
+		for _, raw := range oldUsers.(*schema.Set).List() {
+			oldKeys[userKey(raw.(map[string]interface{}))] = true
+		}
+		if len(oldKeys) > 0 {
+			for _, raw := range newUsers.(*schema.Set).List() {
+				user := raw.(map[string]interface{})
+				if user["name"].(string) == "" || oldKeys[userKey(user)] {
+					continue
+				}
+				cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+				if err != nil {
+					return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+				}
+				exists, response, err := databaseUserExists(context, cloudDatabasesClient, instanceID, user["type"].(string), user["name"].(string))
+				if err != nil {
+					return flex.NewAPIError(err, response).WithOperation("GetConnection").WithResource("ibm_database", instanceID)
+				}
+				if exists {
+					return databaseUserConflictError(user["name"].(string), instanceID)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func waitForICDReady(meta interface{}, instanceID string) error {
 	icdId := flex.EscapeUrlParm(instanceID)
 	icdClient, clientErr := meta.(conns.ClientSession).ICDAPI()
@@ -2413,14 +2537,15 @@ func waitForICDReady(meta interface{}, instanceID string) error {
 		return fmt.Errorf("[ERROR] Error getting database client settings: %s", clientErr)
 	}
 
-	// Wait for ICD Interface
-	err := retry(func() (err error) {
+	// Wait for ICD Interface. The ICD client already retries transient
+	// failures, so only a database that is not found yet is retried here.
+	err := meta.(conns.ClientSession).RetryPolicy().Retry(func() error {
 		_, cdbErr := icdClient.Cdbs().GetCdb(icdId)
 		if cdbErr != nil {
-			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-				return fmt.Errorf("[ERROR] The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err)
+			if apiErr, ok := cdbErr.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				return conns.RetryableError(fmt.Errorf("[ERROR] The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", cdbErr))
 			}
-			return fmt.Errorf("[ERROR] Error getting database config for: %s with error %s\n", icdId, err)
+			return fmt.Errorf("[ERROR] Error getting database config for: %s with error %s\n", icdId, cdbErr)
 		}
 		return nil
 	})
@@ -2448,7 +2573,7 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %s %s", d.Id(), err, response)
 				}
-				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", d.Id(), err, response)
+				return nil, "", flex.NewAPIError(err, response).WithOperation("GetResourceInstance").WithResource("ibm_database", d.Id())
 			}
 			if *instance.State == databaseInstanceFailStatus {
 				return *instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %s %s", d.Id(), err, response)
@@ -2488,7 +2613,7 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %s %s", d.Id(), err, response)
 				}
-				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", d.Id(), err, response)
+				return nil, "", flex.NewAPIError(err, response).WithOperation("GetResourceInstance").WithResource("ibm_database", d.Id())
 			}
 			if *instance.State == databaseInstanceFailStatus {
 				return *instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %s %s", d.Id(), err, response)
@@ -2527,7 +2652,7 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 		case <-delay:
 			innerTask, err = icdClient.Tasks().GetTask(flex.EscapeUrlParm(taskId))
 			if err != nil {
-				return false, fmt.Errorf("[ERROR] The ICD Get task on database update errored: %v", err)
+				return false, flex.NewAPIError(err, nil).WithOperation("GetTask").WithResource("ibm_database", d.Id())
 			}
 			if innerTask.Status == "failed" {
 				return false, fmt.Errorf("[Error] Database task failed")
@@ -2559,7 +2684,7 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return instance, databaseInstanceSuccessStatus, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", d.Id(), err, response)
+				return nil, "", flex.NewAPIError(err, response).WithOperation("GetResourceInstance").WithResource("ibm_database", d.Id())
 			}
 			if *instance.State == databaseInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed to delete: %s %s", d.Id(), err, response)
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMDatabaseAllowlist() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseAllowlistCreate,
+		ReadContext:   resourceIBMDatabaseAllowlistRead,
+		DeleteContext: resourceIBMDatabaseAllowlistDelete,
+		CustomizeDiff: resourceIBMDatabaseAllowlistDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the database deployment",
+			},
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateCIDR,
+				Description:  "Allowlist IP address in CIDR notation",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+				Description:  "Unique allowlist description",
+			},
+		},
+	}
+}
+
+// resourceIBMDatabaseAllowlistDiff fails the plan of an entry whose address is
+// already allowed, e.g. because it is in the allowlist of the ibm_database
+// resource
+func resourceIBMDatabaseAllowlistDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown("deployment_id") || !diff.NewValueKnown("address") {
+		return nil
+	}
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	deploymentID := diff.Get("deployment_id").(string)
+	address := diff.Get("address").(string)
+	entry, response, err := getDatabaseAllowlistEntry(context, cloudDatabasesClient, deploymentID, address)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("GetAllowlist").WithResource("ibm_database_allowlist", deploymentID)
+	}
+	if entry != nil {
+		return databaseAllowlistConflictError(address, deploymentID)
+	}
+	return nil
+}
+
+func resourceIBMDatabaseAllowlistCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	address := d.Get("address").(string)
+
+	unlock, err := conns.IbmKeyedMutex.Lock(context, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	// Adding an address that is already allowed succeeds, so the entry would
+	// be deleted by whichever of this resource and the allowlist of the
+	// ibm_database resource is destroyed first.
+	entry, response, err := getDatabaseAllowlistEntry(context, cloudDatabasesClient, deploymentID, address)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("GetAllowlist").WithResource("ibm_database_allowlist", deploymentID).Diagnostics()
+	}
+	if entry != nil {
+		return diag.FromErr(databaseAllowlistConflictError(address, deploymentID))
+	}
+
+	allowlistEntry := &clouddatabasesv5.AllowlistEntry{
+		Address: core.StringPtr(address),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		allowlistEntry.Description = core.StringPtr(description.(string))
+	}
+	addAllowlistEntryOptions := &clouddatabasesv5.AddAllowlistEntryOptions{
+		ID:        core.StringPtr(deploymentID),
+		IPAddress: allowlistEntry,
+	}
+	addAllowlistEntryResponse, response, err := cloudDatabasesClient.AddAllowlistEntryWithContext(context, addAllowlistEntryOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("AddAllowlistEntry").WithResource("ibm_database_allowlist", address).Diagnostics()
+	}
+
+	d.SetId(strings.Join([]string{deploymentID, address}, databaseIDSeparator))
+
+	_, err = waitForDatabaseTaskComplete(*addAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry (%s) create task to complete: %s", deploymentID, address, err))
+	}
+
+	return resourceIBMDatabaseAllowlistRead(context, d, meta)
+}
+
+func resourceIBMDatabaseAllowlistRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID, address, err := databaseAllowlistIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entry, response, err := getDatabaseAllowlistEntry(context, cloudDatabasesClient, deploymentID, address)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewAPIError(err, response).WithOperation("GetAllowlist").WithResource("ibm_database_allowlist", d.Id()).Diagnostics()
+	}
+	if entry == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("address", entry.Address)
+	d.Set("description", entry.Description)
+	return nil
+}
+
+func resourceIBMDatabaseAllowlistDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID, address, err := databaseAllowlistIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unlock, err := conns.IbmKeyedMutex.Lock(context, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	deleteAllowlistEntryOptions := &clouddatabasesv5.DeleteAllowlistEntryOptions{
+		ID:        core.StringPtr(deploymentID),
+		Ipaddress: core.StringPtr(address),
+	}
+	deleteAllowlistEntryResponse, response, err := cloudDatabasesClient.DeleteAllowlistEntryWithContext(context, deleteAllowlistEntryOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewAPIError(err, response).WithOperation("DeleteAllowlistEntry").WithResource("ibm_database_allowlist", d.Id()).Diagnostics()
+	}
+
+	_, err = waitForDatabaseTaskComplete(*deleteAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry (%s) delete task to complete: %s", deploymentID, address, err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getDatabaseAllowlistEntry returns the allowlist entry of the address, or nil
+// when the address is not allowed
+func getDatabaseAllowlistEntry(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, deploymentID, address string) (*clouddatabasesv5.AllowlistEntry, *core.DetailedResponse, error) {
+	getAllowlistOptions := &clouddatabasesv5.GetAllowlistOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+	allowlist, response, err := cloudDatabasesClient.GetAllowlistWithContext(context, getAllowlistOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	for _, entry := range allowlist.IPAddresses {
+		if entry.Address != nil && *entry.Address == address {
+			return &entry, response, nil
+		}
+	}
+	return nil, response, nil
+}
+
+// databaseAllowlistConflictError is the error of an address that is planned to
+// be added to the allowlist while it is already allowed
+func databaseAllowlistConflictError(address, deploymentID string) error {
+	return fmt.Errorf("[ERROR] The address %s is already in the allowlist of the database (%s). Manage it with either the allowlist of the ibm_database resource or an ibm_database_allowlist resource, and import it with terraform import into the latter", address, deploymentID)
+}
+
+func databaseAllowlistIDParts(id string) (deploymentID, address string, err error) {
+	parts := strings.Split(id, databaseIDSeparator)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID//address", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseAllowlistBasic(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	testName := fmt.Sprintf("tf-pgallow-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_allowlist.entry"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseAllowlistBasic(databaseResourceGroup, testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists("ibm_database."+testName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "address", "172.168.1.2/32"),
+					resource.TestCheckResourceAttr(name, "description", "desc1"),
+					resource.TestCheckResourceAttr("ibm_database."+testName, "allowlist.#", "0"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccCheckIBMDatabaseAllowlistConflict(databaseResourceGroup, testName),
+				ExpectError: regexp.MustCompile("already in the allowlist"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseAllowlistBasic(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		adminpassword     = "password12"
+	}
+
+	resource "ibm_database_allowlist" "entry" {
+		deployment_id = ibm_database.%[2]s.id
+		address       = "172.168.1.2/32"
+		description   = "desc1"
+	}
+				`, databaseResourceGroup, name, acc.IcdDbRegion)
+}
+
+func testAccCheckIBMDatabaseAllowlistConflict(databaseResourceGroup string, name string) string {
+	return testAccCheckIBMDatabaseAllowlistBasic(databaseResourceGroup, name) + fmt.Sprintf(`
+	resource "ibm_database_allowlist" "duplicate" {
+		deployment_id = ibm_database.%[1]s.id
+		address       = "172.168.1.2/32"
+		description   = "desc2"
+	}
+				`, name)
+}
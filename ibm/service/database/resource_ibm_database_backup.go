@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseBackupCreate,
+		ReadContext:   resourceIBMDatabaseBackupRead,
+		DeleteContext: resourceIBMDatabaseBackupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the deployment to back up.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, take a new backup.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of this backup.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of backup.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of this backup.",
+			},
+			"is_downloadable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is this backup available to download?.",
+			},
+			"is_restorable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Can this backup be used to restore an instance?.",
+			},
+			"download_link": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URI which is currently available for file downloading.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time when this backup was created.",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseBackupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+
+	// The task of the backup does not return the backup, which is found as
+	// the newest on demand backup once the task completes. Taking one backup
+	// of the deployment at a time keeps it from being another one.
+	unlock, err := conns.IbmKeyedMutex.Lock(context, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	startOndemandBackupOptions := &clouddatabasesv5.StartOndemandBackupOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+	startOndemandBackupResponse, response, err := cloudDatabasesClient.StartOndemandBackupWithContext(context, startOndemandBackupOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("StartOndemandBackup").WithResource("ibm_database_backup", deploymentID).Diagnostics()
+	}
+	task := startOndemandBackupResponse.Task
+
+	_, err = waitForDatabaseTaskComplete(*task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) backup task to complete: %s", deploymentID, err))
+	}
+
+	startedAt := time.Now().Add(-time.Minute)
+	if task.CreatedAt != nil {
+		startedAt = time.Time(*task.CreatedAt)
+	}
+	listDeploymentBackupsOptions := &clouddatabasesv5.ListDeploymentBackupsOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+	backups, response, err := cloudDatabasesClient.ListDeploymentBackupsWithContext(context, listDeploymentBackupsOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("ListDeploymentBackups").WithResource("ibm_database_backup", deploymentID).Diagnostics()
+	}
+	backup := latestDatabaseBackup(backups.Backups, clouddatabasesv5.BackupTypeOnDemandConst, time.Time{}, false)
+	if backup == nil || backup.CreatedAt == nil || time.Time(*backup.CreatedAt).Before(startedAt) {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error finding the backup of database (%s) taken by task %s", deploymentID, *task.ID))
+	}
+	log.Printf("[INFO] Database (%s) backup task %s took backup %s", deploymentID, *task.ID, *backup.ID)
+
+	d.SetId(*backup.ID)
+
+	return resourceIBMDatabaseBackupRead(context, d, meta)
+}
+
+func resourceIBMDatabaseBackupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getBackupInfoOptions := &clouddatabasesv5.GetBackupInfoOptions{
+		BackupID: core.StringPtr(d.Id()),
+	}
+	backup, response, err := cloudDatabasesClient.GetBackupInfoWithContext(context, getBackupInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewAPIError(err, response).WithOperation("GetBackupInfo").WithResource("ibm_database_backup", d.Id()).Diagnostics()
+	}
+
+	d.Set("backup_id", backup.Backup.ID)
+	d.Set("deployment_id", backup.Backup.DeploymentID)
+	d.Set("type", backup.Backup.Type)
+	d.Set("status", backup.Backup.Status)
+	d.Set("is_downloadable", backup.Backup.IsDownloadable)
+	d.Set("is_restorable", backup.Backup.IsRestorable)
+	d.Set("download_link", backup.Backup.DownloadLink)
+	d.Set("created_at", flex.DateTimeToString(backup.Backup.CreatedAt))
+	return nil
+}
+
+// ICD does not implement an API to delete a backup. The backup is removed
+// from the state and expires with the retention of the deployment.
+func resourceIBMDatabaseBackupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Database backup %s cannot be deleted, removing it from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// latestDatabaseBackup returns the newest backup of the type, or of any type
+// when backupType is empty, created before the time unless it is zero. Only
+// restorable backups are considered when restorable is set.
+func latestDatabaseBackup(backups []clouddatabasesv5.Backup, backupType string, before time.Time, restorable bool) *clouddatabasesv5.Backup {
+	var latest *clouddatabasesv5.Backup
+	for i := range backups {
+		backup := &backups[i]
+		if backup.CreatedAt == nil {
+			continue
+		}
+		if backupType != "" && (backup.Type == nil || *backup.Type != backupType) {
+			continue
+		}
+		if restorable && (backup.IsRestorable == nil || !*backup.IsRestorable) {
+			continue
+		}
+		createdAt := time.Time(*backup.CreatedAt)
+		if !before.IsZero() && !createdAt.Before(before) {
+			continue
+		}
+		if latest == nil || createdAt.After(time.Time(*latest.CreatedAt)) {
+			latest = backup
+		}
+	}
+	return latest
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMDatabaseBackupBasic(t *testing.T) {
+	name := "ibm_database_backup.backup"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseBackupConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "backup_id"),
+					resource.TestCheckResourceAttr(name, "type", "on_demand"),
+					resource.TestCheckResourceAttr(name, "deployment_id", acc.IcdDbDeploymentId),
+					resource.TestCheckResourceAttrPair("data.ibm_database_latest_backup.latest", "backup_id", name, "backup_id"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseBackupConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_database_backup" "backup" {
+			deployment_id = "%[1]s"
+			triggers = {
+				release = "v1"
+			}
+		}
+
+		data "ibm_database_latest_backup" "latest" {
+			deployment_id = ibm_database_backup.backup.deployment_id
+			backup_type   = "on_demand"
+			before        = timeadd(ibm_database_backup.backup.created_at, "1s")
+		}
+	`, acc.IcdDbDeploymentId)
+}
@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMDatabaseConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseConfigurationCreate,
+		ReadContext:   resourceIBMDatabaseConfigurationRead,
+		UpdateContext: resourceIBMDatabaseConfigurationUpdate,
+		DeleteContext: resourceIBMDatabaseConfigurationDelete,
+		CustomizeDiff: resourceIBMDatabaseConfigurationDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the database deployment",
+			},
+			"configuration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDatabaseConfiguration,
+				StateFunc: func(v interface{}) string {
+					json, err := flex.NormalizeJSONString(v)
+					if err != nil {
+						return fmt.Sprintf("%q", err.Error())
+					}
+					return json
+				},
+				Description: "The configuration parameters in JSON format",
+			},
+			"configuration_schema": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration schema in JSON format",
+			},
+		},
+	}
+}
+
+// resourceIBMDatabaseConfigurationDiff fails the plan when the configuration
+// adds a parameter that is already set to another value, e.g. by the
+// configuration of the ibm_database resource
+func resourceIBMDatabaseConfigurationDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("configuration") || !diff.NewValueKnown("deployment_id") || !diff.NewValueKnown("configuration") {
+		return nil
+	}
+	o, n := diff.GetChange("configuration")
+	return databaseConfigurationConflicts(meta, diff.Get("deployment_id").(string), o.(string), n.(string))
+}
+
+func resourceIBMDatabaseConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID := d.Get("deployment_id").(string)
+	configuration, err := databaseConfigurationParameters(d.Get("configuration").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := updateDatabaseConfiguration(context, d, meta, deploymentID, configuration, d.Timeout(schema.TimeoutCreate)); diags != nil {
+		return diags
+	}
+	d.SetId(deploymentID)
+
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+func resourceIBMDatabaseConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(d.Id()),
+	}
+	_, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewAPIError(err, response).WithOperation("GetDeploymentInfo").WithResource("ibm_database_configuration", d.Id()).Diagnostics()
+	}
+
+	configSchema, err := getDatabaseConfigurationSchema(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s, err := json.Marshal(configSchema)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling the database configuration schema: %s", err))
+	}
+	d.Set("deployment_id", d.Id())
+	d.Set("configuration_schema", string(s))
+
+	// The parameters of the configuration are refreshed with their current
+	// values. After an import, the configuration holds the parameters that
+	// are not set to their defaults.
+	configuration, err := databaseConfigurationParameters(d.Get("configuration").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parameters := databaseConfigurationSchemaParameters(configSchema)
+	if len(configuration) == 0 {
+		for name, parameter := range parameters {
+			if current, ok := parameter["current"]; ok && fmt.Sprint(current) != fmt.Sprint(parameter["default"]) {
+				configuration[name] = current
+			}
+		}
+	} else {
+		for name, value := range configuration {
+			if current, ok := parameters[name]["current"]; ok && fmt.Sprint(current) != fmt.Sprint(value) {
+				configuration[name] = current
+			}
+		}
+	}
+	if len(configuration) > 0 {
+		b, err := json.Marshal(configuration)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling the database configuration: %s", err))
+		}
+		d.Set("configuration", string(b))
+	}
+
+	return nil
+}
+
+func resourceIBMDatabaseConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("configuration") {
+		o, n := d.GetChange("configuration")
+		oldConfiguration, err := databaseConfigurationParameters(o.(string))
+		if err != nil {
+			oldConfiguration = map[string]interface{}{}
+		}
+		configuration, err := databaseConfigurationParameters(n.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Parameters removed from the configuration are restored to their
+		// defaults, as they would be had the resource been destroyed
+		var removed []string
+		for name := range oldConfiguration {
+			if _, ok := configuration[name]; !ok {
+				removed = append(removed, name)
+			}
+		}
+		if len(removed) > 0 {
+			defaults, err := databaseConfigurationDefaults(meta, d.Id(), removed)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for name, value := range defaults {
+				configuration[name] = value
+			}
+		}
+
+		if diags := updateDatabaseConfiguration(context, d, meta, d.Id(), configuration, d.Timeout(schema.TimeoutUpdate)); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+func resourceIBMDatabaseConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	configuration, err := databaseConfigurationParameters(d.Get("configuration").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	names := make([]string, 0, len(configuration))
+	for name := range configuration {
+		names = append(names, name)
+	}
+
+	defaults, err := databaseConfigurationDefaults(meta, d.Id(), names)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(defaults) > 0 {
+		if diags := updateDatabaseConfiguration(context, d, meta, d.Id(), defaults, d.Timeout(schema.TimeoutDelete)); diags != nil {
+			return diags
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateDatabaseConfiguration sets the configuration parameters of the
+// deployment and waits for the task to complete
+func updateDatabaseConfiguration(context context.Context, d *schema.ResourceData, meta interface{}, deploymentID string, parameters map[string]interface{}, timeout time.Duration) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	configuration, err := expandDatabaseConfiguration(parameters)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unlock, err := conns.IbmKeyedMutex.Lock(context, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	updateDatabaseConfigurationOptions := &clouddatabasesv5.UpdateDatabaseConfigurationOptions{
+		ID:            core.StringPtr(deploymentID),
+		Configuration: configuration,
+	}
+	updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfigurationWithContext(context, updateDatabaseConfigurationOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("UpdateDatabaseConfiguration").WithResource("ibm_database_configuration", deploymentID).Diagnostics()
+	}
+
+	_, err = waitForDatabaseTaskComplete(*updateDatabaseConfigurationResponse.Task.ID, d, meta, timeout)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) configuration update task to complete: %s", deploymentID, err))
+	}
+	return nil
+}
+
+func validateDatabaseConfiguration(v interface{}, k string) (ws []string, errors []error) {
+	parameters, err := databaseConfigurationParameters(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+		return
+	}
+	if _, err := expandDatabaseConfiguration(parameters); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// databaseConfigurationParameters parses the configuration in JSON format,
+// an empty configuration has no parameters
+func databaseConfigurationParameters(s string) (map[string]interface{}, error) {
+	parameters := map[string]interface{}{}
+	if s == "" {
+		return parameters, nil
+	}
+	if err := json.Unmarshal([]byte(s), &parameters); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the database configuration: %s", err)
+	}
+	return parameters, nil
+}
+
+// expandDatabaseConfiguration returns the parameters as a configuration,
+// failing on parameters that no database supports
+func expandDatabaseConfiguration(parameters map[string]interface{}) (*clouddatabasesv5.Configuration, error) {
+	b, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	configuration := &clouddatabasesv5.Configuration{}
+	if err := decoder.Decode(configuration); err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid database configuration: %s", err)
+	}
+	return configuration, nil
+}
+
+func getDatabaseConfigurationSchema(meta interface{}, deploymentID string) (interface{}, error) {
+	icdClient, err := meta.(conns.ClientSession).ICDAPI()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+	configSchema, err := icdClient.Configurations().GetConfiguration(deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting database (%s) configuration schema : %s", deploymentID, err)
+	}
+	return configSchema, nil
+}
+
+// databaseConfigurationSchemaParameters returns the parameters of the
+// configuration schema of a deployment by name
+func databaseConfigurationSchemaParameters(configSchema interface{}) map[string]map[string]interface{} {
+	parameters := map[string]map[string]interface{}{}
+	m, _ := configSchema.(map[string]interface{})
+	s, _ := m["schema"].(map[string]interface{})
+	for name, raw := range s {
+		if parameter, ok := raw.(map[string]interface{}); ok {
+			parameters[name] = parameter
+		}
+	}
+	return parameters
+}
+
+// databaseConfigurationDefaults returns the defaults of the configuration
+// parameters from the configuration schema of the deployment
+func databaseConfigurationDefaults(meta interface{}, deploymentID string, names []string) (map[string]interface{}, error) {
+	configSchema, err := getDatabaseConfigurationSchema(meta, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	parameters := databaseConfigurationSchemaParameters(configSchema)
+
+	defaults := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		value, ok := parameters[name]["default"]
+		if !ok {
+			log.Printf("[WARN] The database (%s) configuration schema has no default for %s, leaving it unchanged", deploymentID, name)
+			continue
+		}
+		defaults[name] = value
+	}
+	return defaults, nil
+}
+
+// databaseConfigurationConflicts fails when the new configuration adds a
+// parameter that the deployment already sets to a value other than its
+// default and the new value, e.g. because it is managed by both the
+// ibm_database and the ibm_database_configuration resources
+func databaseConfigurationConflicts(meta interface{}, deploymentID, oldConfiguration, newConfiguration string) error {
+	oldParameters, err := databaseConfigurationParameters(oldConfiguration)
+	if err != nil {
+		oldParameters = map[string]interface{}{}
+	}
+	newParameters, err := databaseConfigurationParameters(newConfiguration)
+	if err != nil {
+		return err
+	}
+
+	var parameters map[string]map[string]interface{}
+	for name, value := range newParameters {
+		if _, ok := oldParameters[name]; ok {
+			continue
+		}
+		if parameters == nil {
+			configSchema, err := getDatabaseConfigurationSchema(meta, deploymentID)
+			if err != nil {
+				return err
+			}
+			parameters = databaseConfigurationSchemaParameters(configSchema)
+		}
+		current, ok := parameters[name]["current"]
+		if ok && fmt.Sprint(current) != fmt.Sprint(parameters[name]["default"]) && fmt.Sprint(current) != fmt.Sprint(value) {
+			return fmt.Errorf("[ERROR] The configuration parameter %s of the database (%s) is already set to %v. Manage it with either the configuration of the ibm_database resource or an ibm_database_configuration resource", name, deploymentID, current)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseConfigurationBasic(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	testName := fmt.Sprintf("tf-pgconf-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_configuration.configuration"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseConfigurationBasic(databaseResourceGroup, testName, `{"max_connections": 200, "deadlock_timeout": 10000}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists("ibm_database."+testName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "configuration", `{"deadlock_timeout":10000,"max_connections":200}`),
+					resource.TestCheckResourceAttrSet(name, "configuration_schema"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseConfigurationBasic(databaseResourceGroup, testName, `{"max_connections": 250}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "configuration", `{"max_connections":250}`),
+				),
+			},
+			{
+				Config:      testAccCheckIBMDatabaseConfigurationBasic(databaseResourceGroup, testName, `{"max_connection": 250}`),
+				ExpectError: regexp.MustCompile("unknown field"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseConfigurationBasic(databaseResourceGroup string, name string, configuration string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		adminpassword     = "password12"
+	}
+
+	resource "ibm_database_configuration" "configuration" {
+		deployment_id = ibm_database.%[2]s.id
+		configuration = <<CONFIGURATION
+%[4]s
+CONFIGURATION
+	}
+				`, databaseResourceGroup, name, acc.IcdDbRegion, configuration)
+}
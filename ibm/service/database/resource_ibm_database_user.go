@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// databaseIDSeparator separates the parts of the IDs of the resources of a
+// database deployment. The deployment ID is a CRN, and allowlist addresses are
+// CIDRs, so both can contain a single slash.
+const databaseIDSeparator = "//"
+
+func ResourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		CustomizeDiff: resourceIBMDatabaseUserDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMDatabaseUserImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the database deployment",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_database_user", "name"),
+				Description:  "User name",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_database_user", "password"),
+				Description:  "User password. Changing the password rotates it in place",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "database",
+				ValidateFunc: validate.InvokeValidator("ibm_database_user", "type"),
+				Description:  "User type",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_database_user", "role"),
+				Description:  "User role. Only available for ops_manager user type.",
+			},
+			"password_rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the password was last set by Terraform",
+			},
+		},
+	}
+}
+
+func ResourceIBMDatabaseUserValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.StringLenBetween,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			MinValueLength:             5,
+			MaxValueLength:             32},
+		validate.ValidateSchema{
+			Identifier:                 "password",
+			ValidateFunctionIdentifier: validate.StringLenBetween,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			MinValueLength:             10,
+			MaxValueLength:             32},
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "database, ops_manager, read_only_replica"},
+		validate.ValidateSchema{
+			Identifier:                 "role",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "group_read_only, group_data_access_admin"})
+
+	ibmDatabaseUserResourceValidator := validate.ResourceValidator{ResourceName: "ibm_database_user", Schema: validateSchema}
+	return &ibmDatabaseUserResourceValidator
+}
+
+// resourceIBMDatabaseUserDiff fails the plan of a user that already exists,
+// e.g. because it is in the users of the ibm_database resource
+func resourceIBMDatabaseUserDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown("deployment_id") || !diff.NewValueKnown("name") || !diff.NewValueKnown("type") {
+		return nil
+	}
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	deploymentID := diff.Get("deployment_id").(string)
+	name := diff.Get("name").(string)
+	exists, response, err := databaseUserExists(context, cloudDatabasesClient, deploymentID, diff.Get("type").(string), name)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("GetConnection").WithResource("ibm_database_user", name)
+	}
+	if exists {
+		return databaseUserConflictError(name, deploymentID)
+	}
+	return nil
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	userType := d.Get("type").(string)
+	user := &clouddatabasesv5.User{
+		Username: core.StringPtr(d.Get("name").(string)),
+		Password: core.StringPtr(d.Get("password").(string)),
+	}
+	// User Role only for ops_manager user type
+	if role, ok := d.GetOk("role"); ok {
+		if userType != "ops_manager" {
+			return diag.FromErr(fmt.Errorf("[ERROR] role can only be set for users of type ops_manager"))
+		}
+		user.Role = core.StringPtr(role.(string))
+	}
+
+	unlock, err := conns.IbmKeyedMutex.Lock(context, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	createDatabaseUserOptions := &clouddatabasesv5.CreateDatabaseUserOptions{
+		ID:       core.StringPtr(deploymentID),
+		UserType: core.StringPtr(userType),
+		User:     user,
+	}
+	createDatabaseUserResponse, response, err := cloudDatabasesClient.CreateDatabaseUserWithContext(context, createDatabaseUserOptions)
+	if err != nil {
+		if response != nil && (response.StatusCode == http.StatusConflict || response.StatusCode == http.StatusUnprocessableEntity) {
+			return diag.FromErr(fmt.Errorf("[ERROR] CreateDatabaseUser (%s) failed, the user may already exist in the users of the ibm_database resource. Remove it from there and import it with terraform import: %s\n%s", *user.Username, err, response))
+		}
+		return flex.NewAPIError(err, response).WithOperation("CreateDatabaseUser").WithResource("ibm_database_user", *user.Username).Diagnostics()
+	}
+
+	d.SetId(strings.Join([]string{deploymentID, userType, *user.Username}, databaseIDSeparator))
+
+	_, err = waitForDatabaseTaskComplete(*createDatabaseUserResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) user (%s) create task to complete: %s", deploymentID, *user.Username, err))
+	}
+	d.Set("password_rotated_at", time.Now().UTC().Format(time.RFC3339))
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID, userType, name, err := databaseUserIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	exists, response, err := databaseUserExists(context, cloudDatabasesClient, deploymentID, userType, name)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("GetConnection").WithResource("ibm_database_user", d.Id()).Diagnostics()
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("type", userType)
+	d.Set("name", name)
+	return nil
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	if d.HasChange("password") {
+		deploymentID := d.Get("deployment_id").(string)
+		unlock, err := conns.IbmKeyedMutex.Lock(context, deploymentID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer unlock()
+
+		changeUserPasswordOptions := &clouddatabasesv5.ChangeUserPasswordOptions{
+			ID:       core.StringPtr(deploymentID),
+			UserType: core.StringPtr(d.Get("type").(string)),
+			Username: core.StringPtr(d.Get("name").(string)),
+			User: &clouddatabasesv5.APasswordSettingUser{
+				Password: core.StringPtr(d.Get("password").(string)),
+			},
+		}
+		changeUserPasswordResponse, response, err := cloudDatabasesClient.ChangeUserPasswordWithContext(context, changeUserPasswordOptions)
+		if err != nil {
+			return flex.NewAPIError(err, response).WithOperation("ChangeUserPassword").WithResource("ibm_database_user", d.Id()).Diagnostics()
+		}
+
+		_, err = waitForDatabaseTaskComplete(*changeUserPasswordResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error waiting for database (%s) user (%s) password update task to complete: %s", deploymentID, *changeUserPasswordOptions.Username, err))
+		}
+		d.Set("password_rotated_at", time.Now().UTC().Format(time.RFC3339))
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID, userType, name, err := databaseUserIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unlock, err := conns.IbmKeyedMutex.Lock(context, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	deleteDatabaseUserOptions := &clouddatabasesv5.DeleteDatabaseUserOptions{
+		ID:       core.StringPtr(deploymentID),
+		UserType: core.StringPtr(userType),
+		Username: core.StringPtr(name),
+	}
+	deleteDatabaseUserResponse, response, err := cloudDatabasesClient.DeleteDatabaseUserWithContext(context, deleteDatabaseUserOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewAPIError(err, response).WithOperation("DeleteDatabaseUser").WithResource("ibm_database_user", d.Id()).Diagnostics()
+	}
+
+	_, err = waitForDatabaseTaskComplete(*deleteDatabaseUserResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) user (%s) delete task to complete: %s", deploymentID, name, err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMDatabaseUserImport imports a user with the ID
+// <deployment_id>//<type>//<name>. The password cannot be read, so it is set
+// from the configuration by the next apply.
+func resourceIBMDatabaseUserImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	deploymentID, userType, name, err := databaseUserIDParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("deployment_id", deploymentID)
+	d.Set("type", userType)
+	d.Set("name", name)
+	return []*schema.ResourceData{d}, nil
+}
+
+// databaseUserExists reports whether the user exists. The API has no operation
+// to get a user, but the connection of the user is only found while the user
+// exists.
+func databaseUserExists(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, deploymentID, userType, name string) (bool, *core.DetailedResponse, error) {
+	var response *core.DetailedResponse
+	var err error
+	for _, endpointType := range []string{"public", "private"} {
+		getConnectionOptions := &clouddatabasesv5.GetConnectionOptions{
+			ID:           core.StringPtr(deploymentID),
+			UserType:     core.StringPtr(userType),
+			UserID:       core.StringPtr(name),
+			EndpointType: core.StringPtr(endpointType),
+		}
+		_, response, err = cloudDatabasesClient.GetConnectionWithContext(context, getConnectionOptions)
+		if err == nil {
+			return true, response, nil
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return false, response, nil
+		}
+	}
+	return false, response, err
+}
+
+// databaseUserConflictError is the error of a user that is planned to be
+// created while it already exists
+func databaseUserConflictError(name, deploymentID string) error {
+	return fmt.Errorf("[ERROR] The user %s already exists in the database (%s). Manage it with either the users of the ibm_database resource or an ibm_database_user resource, and import it with terraform import into the latter", name, deploymentID)
+}
+
+func databaseUserIDParts(id string) (deploymentID, userType, name string, err error) {
+	parts := strings.Split(id, databaseIDSeparator)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID//userType//userName", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
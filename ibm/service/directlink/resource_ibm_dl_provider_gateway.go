@@ -28,6 +28,9 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 		Update:   resourceIBMdlProviderGatewayUpdate,
 		Importer: &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
@@ -127,6 +130,13 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -92,8 +92,11 @@ func resourceIBMPrivateDNSLocationCreate(context context.Context, d *schema.Reso
 	resolverID := d.Get(pdnsResolverID).(string)
 
 	mk := "private_dns_resource_custom_resolver_location_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedMutex.Lock(context, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	opt := sess.NewAddCustomResolverLocationOptions(instanceID, resolverID)
 
@@ -173,8 +176,11 @@ func resourceIBMPrivateDNSLocationUpdate(context context.Context, d *schema.Reso
 	locationID, resolverID, instanceID, err := flex.ConvertTfToCisThreeVar(d.Id())
 
 	mk := "private_dns_resource_custom_resolver_location_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedMutex.Lock(context, mk)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	updatelocation := sess.NewUpdateCustomResolverLocationOptions(instanceID, resolverID, locationID)
 
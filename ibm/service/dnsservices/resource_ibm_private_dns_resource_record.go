@@ -301,8 +301,11 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + instanceID + zoneID + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutCreate), mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating pdns resource record:%s\n%s", err, detail)
@@ -392,8 +395,11 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutUpdate), mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	updateResourceRecordOptions := sess.NewUpdateResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 
@@ -501,8 +507,11 @@ func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta inte
 	randI := fmt.Sprint(rand.Intn(50))
 	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutDelete), mk)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting pdns resource record:%s\n%s", err, response)
@@ -526,8 +535,11 @@ func resourceIBMPrivateDNSResourceRecordExists(d *schema.ResourceData, meta inte
 	randI := fmt.Sprint(rand.Intn(50))
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutRead), mk)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
 	_, response, err := sess.GetResourceRecord(getResourceRecordOptions)
 
 	if err != nil {
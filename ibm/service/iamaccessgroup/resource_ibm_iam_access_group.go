@@ -5,9 +5,9 @@ package iamaccessgroup
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -66,8 +66,11 @@ func resourceIBMIAMAccessGroupCreate(context context.Context, d *schema.Resource
 		creatAccessGroupOptions.Description = &description
 	}
 	agrp, detailedResponse, err := iamAccessGroupsClient.CreateAccessGroup(creatAccessGroupOptions)
-	if err != nil || agrp == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating access group: %s. API Response: %s", err, detailedResponse))
+	if err != nil {
+		return flex.NewAPIError(err, detailedResponse).WithOperation("CreateAccessGroup").WithResource("ibm_iam_access_group", d.Id()).Diagnostics()
+	}
+	if agrp == nil {
+		return diag.Errorf("[ERROR] Error calling CreateAccessGroup for ibm_iam_access_group: empty response")
 	}
 
 	d.SetId(*agrp.ID)
@@ -88,7 +91,7 @@ func resourceIBMIAMAccessGroupRead(context context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group: %s. API Response: %s", err, detailedResponse))
+		return flex.NewAPIError(err, detailedResponse).WithOperation("GetAccessGroup").WithResource("ibm_iam_access_group", d.Id()).Diagnostics()
 	}
 	version := detailedResponse.GetHeaders().Get("etag")
 	d.Set("name", agrp.Name)
@@ -123,8 +126,11 @@ func resourceIBMIAMAccessGroupUpdate(context context.Context, d *schema.Resource
 
 	if hasChange {
 		agrp, detailedResponse, err := iamAccessGroupsClient.UpdateAccessGroup(updateAccessGroupOptions)
-		if err != nil || agrp == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating access group: %s. API Response: %s", err, detailedResponse))
+		if err != nil {
+			return flex.NewAPIError(err, detailedResponse).WithOperation("UpdateAccessGroup").WithResource("ibm_iam_access_group", d.Id()).Diagnostics()
+		}
+		if agrp == nil {
+			return diag.Errorf("[ERROR] Error calling UpdateAccessGroup for ibm_iam_access_group: empty response")
 		}
 	}
 
@@ -144,7 +150,7 @@ func resourceIBMIAMAccessGroupDelete(context context.Context, d *schema.Resource
 	deleteAccessGroupOptions.SetForce(force)
 	detailedResponse, err := iamAccessGroupsClient.DeleteAccessGroup(deleteAccessGroupOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting access group: %s, API Response: %s", err, detailedResponse))
+		return flex.NewAPIError(err, detailedResponse).WithOperation("DeleteAccessGroup").WithResource("ibm_iam_access_group", d.Id()).Diagnostics()
 	}
 
 	d.SetId("")
@@ -147,7 +147,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	listAccessGroupMembersOptions.SetLimit(limit)
 	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+		return flex.NewAPIError(err, detailedResponse).WithOperation("ListAccessGroupMembers").WithResource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 	}
 	allMembers := members.Members
 	totalMembers := flex.IntValue(members.TotalCount)
@@ -156,7 +156,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 		listAccessGroupMembersOptions.SetOffset(offset)
 		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+			return flex.NewAPIError(err, detailedResponse).WithOperation("ListAccessGroupMembers").WithResource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 		}
 		allMembers = append(allMembers, members.Members...)
 	}
@@ -199,7 +199,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp))
+			return flex.NewAPIError(err, resp).WithOperation("ListServiceIds").WithResource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 		}
 		start = flex.GetNextIAM(serviceIDs.Next)
 		allrecs = append(allrecs, serviceIDs.Serviceids...)
@@ -222,7 +222,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 		profileIDs, resp, err := iamClient.ListProfiles(&listProfilesOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Trusted Profiles %s %s", err, resp))
+			return flex.NewAPIError(err, resp).WithOperation("ListProfiles").WithResource("ibm_iam_access_group_members", d.Id()).Diagnostics()
 		}
 		profileStart = flex.GetNextIAM(profileIDs.Next)
 		allprofiles = append(allprofiles, profileIDs.Profiles...)
@@ -337,8 +337,11 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 				ID: &s,
 			}
 			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
-			if err != nil || serviceID == nil {
-				return diag.FromErr(fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp))
+			if err != nil {
+				return flex.NewAPIError(err, resp).WithOperation("GetServiceID").WithResource("ibm_iam_access_group_members", d.Id()).Diagnostics()
+			}
+			if serviceID == nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error calling GetServiceID for ibm_iam_access_group_members: empty response"))
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *serviceID.IamID)
 			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
@@ -353,8 +356,11 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 				ProfileID: &p,
 			}
 			profileID, resp, err := iamClient.GetProfile(&getProfileOptions)
-			if err != nil || profileID == nil {
-				return diag.FromErr(fmt.Errorf("ERROR] Error Getting Profile Ids %s %s", err, resp))
+			if err != nil {
+				return flex.NewAPIError(err, resp).WithOperation("GetProfile").WithResource("ibm_iam_access_group_members", d.Id()).Diagnostics()
+			}
+			if profileID == nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error calling GetProfile for ibm_iam_access_group_members: empty response"))
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *profileID.IamID)
 			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
@@ -490,8 +496,11 @@ func getServiceID(id string, meta interface{}) (iamidentityv1.ServiceID, error)
 		ID: &id,
 	}
 	serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
-	if err != nil || serviceID == nil {
-		return serviceids, fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp)
+	if err != nil {
+		return serviceids, flex.NewAPIError(err, resp).WithOperation("GetServiceID").WithResource("ibm_iam_access_group_members", "")
+	}
+	if serviceID == nil {
+		return serviceids, fmt.Errorf("[ERROR] Error calling GetServiceID for ibm_iam_access_group_members: empty response")
 	}
 	return *serviceID, nil
 }
@@ -530,8 +539,11 @@ func getProfileID(id string, meta interface{}) (iamidentityv1.TrustedProfile, er
 		ProfileID: &id,
 	}
 	profileID, resp, err := iamClient.GetProfile(&getProfileOptions)
-	if err != nil || profileID == nil {
-		return profileids, fmt.Errorf("ERROR] Error Getting Profile Ids %s %s", err, resp)
+	if err != nil {
+		return profileids, flex.NewAPIError(err, resp).WithOperation("GetProfile").WithResource("ibm_iam_access_group_members", "")
+	}
+	if profileID == nil {
+		return profileids, fmt.Errorf("[ERROR] Error calling GetProfile for ibm_iam_access_group_members: empty response")
 	}
 	return *profileID, nil
 }
@@ -17,6 +17,8 @@ import (
 )
 
 func TestAccIBMIAMAccessGroup_Basic(t *testing.T) {
+	defer acc.Replay(t)()
+
 	var conf iamaccessgroupsv2.Group
 	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
 	updateName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
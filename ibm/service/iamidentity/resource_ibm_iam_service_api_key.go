@@ -4,15 +4,19 @@
 package iamidentity
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -26,6 +30,8 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 		Exists:   resourceIBMIAMServiceAPIKeyExists,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMIAMServiceAPIKeyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -58,7 +64,6 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Sensitive:   true,
-				ForceNew:    true,
 				Description: "API key value for this API key",
 			},
 
@@ -83,6 +88,88 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Description:      "File where api key is to be stored",
 			},
 
+			"rotation_period": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateAPIKeyRotationDuration,
+				ConflictsWith: []string{"apikey"},
+				Description:   "Duration after which the API key is replaced by a new key on the next apply, for example 2160h",
+			},
+
+			"rotate_on": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"apikey"},
+				Description:   "Arbitrary values that, when changed, replace the API key by a new key",
+			},
+
+			"rotation_overlap_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAPIKeyRotationDuration,
+				Description:  "Duration the previous API key is kept after a rotation; it is deleted on the first apply after the overlap",
+			},
+
+			"secrets_manager": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Arbitrary secret of a Secrets Manager instance the API key value is published to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Secrets Manager instance GUID",
+						},
+						"secret_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the arbitrary secret that receives the API key value",
+						},
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+							Description:  "Endpoint Type. 'public' or 'private'",
+						},
+					},
+				},
+			},
+
+			"previous_apikey_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the API key that was replaced by the last rotation",
+			},
+
+			"previous_apikey": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Value of the API key that was replaced by the last rotation",
+			},
+
+			"previous_apikey_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time after which the previous API key is deleted on the next apply",
+			},
+
+			"rotation_due": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the API key was older than rotation_period when it was last read; it is rotated on the next apply",
+			},
+
+			"previous_apikey_expired": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the overlap of the previous API key had ended when it was last read; it is deleted on the next apply",
+			},
+
 			"crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -130,52 +217,30 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	name := d.Get("name").(string)
-	iamID := d.Get("iam_service_id").(string)
-
-	createAPIKeyOptions := &iamidentityv1.CreateAPIKeyOptions{
-		Name:  &name,
-		IamID: &iamID,
-	}
-
-	if des, ok := d.GetOk("description"); ok {
-		desString := des.(string)
-		createAPIKeyOptions.Description = &desString
-	}
-
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
 		return err
 	}
-	createAPIKeyOptions.AccountID = &userDetails.UserAccount
+	createAPIKeyOptions := expandServiceAPIKeyCreateOptions(d, userDetails.UserAccount)
 
 	if key, ok := d.GetOk("apikey"); ok {
 		apikeyString := key.(string)
 		createAPIKeyOptions.Apikey = &apikeyString
 	}
 
-	if strvalue, ok := d.GetOk("store_value"); ok {
-		value := strvalue.(bool)
-		createAPIKeyOptions.StoreValue = &value
-	}
-
-	if lock, ok := d.GetOk("locked"); ok {
-		elockstr := strconv.FormatBool(lock.(bool))
-		createAPIKeyOptions.EntityLock = &elockstr
-	}
-
 	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
-	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("CreateAPIKey").WithResource("ibm_iam_service_api_key", d.Id())
+	}
+	if apiKey == nil {
+		return fmt.Errorf("[ERROR] Error calling CreateAPIKey for ibm_iam_service_api_key: empty response")
 	}
 
 	d.SetId(*apiKey.ID)
 	d.Set("apikey", *apiKey.Apikey)
 
-	if keyfile, ok := d.GetOk("file"); ok {
-		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
-			log.Printf("Error writing API Key Details to file: %s", err)
-		}
+	if err := publishServiceAPIKey(d, meta, apiKey); err != nil {
+		return err
 	}
 
 	return resourceIBMIAMServiceAPIKeyRead(d, meta)
@@ -198,7 +263,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetAPIKey").WithResource("ibm_iam_service_api_key", d.Id())
 	}
 	if apiKey.Name != nil {
 		d.Set("name", *apiKey.Name)
@@ -234,6 +299,27 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("modified_at", apiKey.ModifiedAt.String())
 	}
 
+	if previousID := d.Get("previous_apikey_id").(string); previousID != "" {
+		getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
+			ID: &previousID,
+		}
+		_, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
+		if err != nil {
+			if response == nil || response.StatusCode != 404 {
+				return flex.NewAPIError(err, response).WithOperation("GetAPIKey").WithResource("ibm_iam_service_api_key", previousID)
+			}
+			log.Printf("[DEBUG] previous API key %s no longer exists", previousID)
+			clearPreviousServiceAPIKey(d)
+		}
+	}
+
+	// Like the time_rotating resource, the decisions that depend on the time
+	// are made when the key is read, and the plan only reacts to the state.
+	// The plan and the apply then agree on whether the key is rotated.
+	now := time.Now()
+	d.Set("rotation_due", serviceAPIKeyRotationDue(d, now))
+	d.Set("previous_apikey_expired", serviceAPIKeyPreviousExpired(d, now))
+
 	return nil
 }
 
@@ -243,6 +329,30 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+
+	// The plan leaves the key value unknown when CustomizeDiff decided to rotate
+	if plan := d.GetRawPlan(); !plan.IsNull() && !plan.GetAttr("apikey").IsKnown() {
+		return resourceIBMIAMServiceAPIKeyRotate(d, meta, iamIdentityClient)
+	}
+
+	if d.HasChange("previous_apikey_id") && d.Get("previous_apikey_id").(string) == "" {
+		oldID, _ := d.GetChange("previous_apikey_id")
+		if err := deleteServiceAPIKey(iamIdentityClient, oldID.(string), d.Get("locked").(bool)); err != nil {
+			return err
+		}
+		clearPreviousServiceAPIKey(d)
+	}
+
+	if d.HasChange("secrets_manager") {
+		if value := d.Get("apikey").(string); value != "" {
+			if err := publishServiceAPIKeyToSecretsManager(d, meta, value); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[WARN] the value of API key %s is not known and cannot be published to Secrets Manager", d.Id())
+		}
+	}
+
 	apiKeyID := d.Id()
 
 	getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
@@ -250,8 +360,11 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	apiKey, resp, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
-	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, resp)
+	if err != nil {
+		return flex.NewAPIError(err, resp).WithOperation("GetAPIKey").WithResource("ibm_iam_service_api_key", d.Id())
+	}
+	if apiKey == nil {
+		return fmt.Errorf("[ERROR] Error calling GetAPIKey for ibm_iam_service_api_key: empty response")
 	}
 
 	updateAPIKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{
@@ -275,7 +388,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if hasChange {
 		_, response, err := iamIdentityClient.UpdateAPIKey(updateAPIKeyOptions)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("UpdateAPIKey").WithResource("ibm_iam_service_api_key", d.Id())
 		}
 	}
 
@@ -300,7 +413,13 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetAPIKey").WithResource("ibm_iam_service_api_key", d.Id())
+	}
+
+	if previousID := d.Get("previous_apikey_id").(string); previousID != "" {
+		if err := deleteServiceAPIKey(iamIdentityClient, previousID, d.Get("locked").(bool)); err != nil {
+			return err
+		}
 	}
 
 	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
@@ -309,7 +428,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 
 	resp, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
+		return flex.NewAPIError(err, resp).WithOperation("DeleteAPIKey").WithResource("ibm_iam_service_api_key", d.Id())
 	}
 	d.SetId("")
 
@@ -332,11 +451,255 @@ func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error retrieving Service API Key: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).WithOperation("GetAPIKey").WithResource("ibm_iam_service_api_key", d.Id())
 	}
 	return *apiKey.ID == apiKeyID, nil
 }
 
+func resourceIBMIAMServiceAPIKeyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// A configured key value cannot be changed in place
+	if diff.HasChange("apikey") {
+		return diff.ForceNew("apikey")
+	}
+
+	// rotation_due is only acted on while rotation_period is still set
+	_, periodSet := diff.GetOk("rotation_period")
+	if diff.HasChange("rotate_on") || (periodSet && diff.Get("rotation_due").(bool)) {
+		for _, k := range []string{"apikey", "previous_apikey_id", "previous_apikey", "previous_apikey_expires_at", "rotation_due", "previous_apikey_expired", "crn", "entity_tag", "created_by", "created_at", "modified_at"} {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if diff.Get("previous_apikey_expired").(bool) {
+		for _, k := range []string{"previous_apikey_id", "previous_apikey", "previous_apikey_expires_at"} {
+			if err := diff.SetNew(k, ""); err != nil {
+				return err
+			}
+		}
+		if err := diff.SetNew("previous_apikey_expired", false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serviceAPIKeyRotationDue reports whether the current key is older than
+// rotation_period.
+func serviceAPIKeyRotationDue(d *schema.ResourceData, now time.Time) bool {
+	period, ok := d.GetOk("rotation_period")
+	if !ok {
+		return false
+	}
+	duration, err := time.ParseDuration(period.(string))
+	if err != nil {
+		return false
+	}
+	createdAt, err := time.Parse(time.RFC3339, d.Get("created_at").(string))
+	if err != nil {
+		log.Printf("[DEBUG] cannot determine the age of API key %s: %s", d.Id(), err)
+		return false
+	}
+	return !now.Before(createdAt.Add(duration))
+}
+
+// serviceAPIKeyPreviousExpired reports whether the overlap of the key replaced
+// by the last rotation has ended.
+func serviceAPIKeyPreviousExpired(d *schema.ResourceData, now time.Time) bool {
+	if d.Get("previous_apikey_id").(string) == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, d.Get("previous_apikey_expires_at").(string))
+	return err != nil || !now.Before(expiresAt)
+}
+
+// resourceIBMIAMServiceAPIKeyRotate replaces the API key by a new key and keeps
+// the replaced key as the previous key. A previous key left by an earlier
+// rotation is deleted first.
+func resourceIBMIAMServiceAPIKeyRotate(d *schema.ResourceData, meta interface{}, iamIdentityClient *iamidentityv1.IamIdentityV1) error {
+	oldPreviousID, _ := d.GetChange("previous_apikey_id")
+	if id := oldPreviousID.(string); id != "" {
+		if err := deleteServiceAPIKey(iamIdentityClient, id, d.Get("locked").(bool)); err != nil {
+			return err
+		}
+		clearPreviousServiceAPIKey(d)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	createAPIKeyOptions := expandServiceAPIKeyCreateOptions(d, userDetails.UserAccount)
+
+	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("CreateAPIKey").WithResource("ibm_iam_service_api_key", d.Id())
+	}
+	if apiKey == nil {
+		return fmt.Errorf("[ERROR] Error calling CreateAPIKey for ibm_iam_service_api_key: empty response")
+	}
+
+	var overlap time.Duration
+	if v, ok := d.GetOk("rotation_overlap_period"); ok {
+		overlap, _ = time.ParseDuration(v.(string))
+	}
+	previousValue, _ := d.GetChange("apikey")
+
+	d.Set("previous_apikey_id", d.Id())
+	d.Set("previous_apikey", previousValue)
+	d.Set("previous_apikey_expires_at", time.Now().Add(overlap).UTC().Format(time.RFC3339))
+	d.SetId(*apiKey.ID)
+	d.Set("apikey", *apiKey.Apikey)
+	log.Printf("[INFO] rotated API key %s to %s", d.Get("previous_apikey_id"), d.Id())
+
+	if err := publishServiceAPIKey(d, meta, apiKey); err != nil {
+		return err
+	}
+
+	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+}
+
+func expandServiceAPIKeyCreateOptions(d *schema.ResourceData, accountID string) *iamidentityv1.CreateAPIKeyOptions {
+	name := d.Get("name").(string)
+	iamID := d.Get("iam_service_id").(string)
+
+	createAPIKeyOptions := &iamidentityv1.CreateAPIKeyOptions{
+		Name:      &name,
+		IamID:     &iamID,
+		AccountID: &accountID,
+	}
+
+	if des, ok := d.GetOk("description"); ok {
+		desString := des.(string)
+		createAPIKeyOptions.Description = &desString
+	}
+
+	if strvalue, ok := d.GetOk("store_value"); ok {
+		value := strvalue.(bool)
+		createAPIKeyOptions.StoreValue = &value
+	}
+
+	if lock, ok := d.GetOk("locked"); ok {
+		elockstr := strconv.FormatBool(lock.(bool))
+		createAPIKeyOptions.EntityLock = &elockstr
+	}
+
+	return createAPIKeyOptions
+}
+
+func deleteServiceAPIKey(iamIdentityClient *iamidentityv1.IamIdentityV1, apiKeyID string, locked bool) error {
+	if locked {
+		unlockAPIKeyOptions := &iamidentityv1.UnlockAPIKeyOptions{
+			ID: &apiKeyID,
+		}
+		response, err := iamIdentityClient.UnlockAPIKey(unlockAPIKeyOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return flex.NewAPIError(err, response).WithOperation("UnlockAPIKey").WithResource("ibm_iam_service_api_key", apiKeyID)
+		}
+	}
+
+	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
+		ID: &apiKeyID,
+	}
+	response, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return flex.NewAPIError(err, response).WithOperation("DeleteAPIKey").WithResource("ibm_iam_service_api_key", apiKeyID)
+	}
+	return nil
+}
+
+func clearPreviousServiceAPIKey(d *schema.ResourceData) {
+	d.Set("previous_apikey_id", "")
+	d.Set("previous_apikey", "")
+	d.Set("previous_apikey_expires_at", "")
+}
+
+// publishServiceAPIKey writes a new API key to the configured file and
+// Secrets Manager secret.
+func publishServiceAPIKey(d *schema.ResourceData, meta interface{}, apiKey *iamidentityv1.APIKey) error {
+	if keyfile, ok := d.GetOk("file"); ok {
+		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+			log.Printf("Error writing API Key Details to file: %s", err)
+		}
+	}
+	if _, ok := d.GetOk("secrets_manager"); ok {
+		return publishServiceAPIKeyToSecretsManager(d, meta, *apiKey.Apikey)
+	}
+	return nil
+}
+
+// publishServiceAPIKeyToSecretsManager stores the value as a new version of
+// the configured arbitrary secret.
+func publishServiceAPIKeyToSecretsManager(d *schema.ResourceData, meta interface{}, value string) error {
+	config, ok := d.Get("secrets_manager").([]interface{})
+	if !ok || len(config) == 0 || config[0] == nil {
+		return nil
+	}
+	sm := config[0].(map[string]interface{})
+
+	bluemixSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	region := bluemixSession.Config.Region
+
+	sess, err := meta.(conns.ClientSession).SecretsManagerV1()
+	if err != nil {
+		return err
+	}
+	// The client of the session is shared, so the URL of the instance is set
+	// on a copy
+	secretsManagerClient := sess.Clone()
+
+	instanceID := sm["instance_id"].(string)
+	var smEndpointURL string
+	if sm["endpoint_type"].(string) == "private" {
+		smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+	} else {
+		smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+	}
+	if err := secretsManagerClient.SetServiceURL(meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT", smEndpointURL)); err != nil {
+		return err
+	}
+
+	secretType := secretsmanagerv1.UpdateSecretOptionsSecretTypeArbitraryConst
+	action := secretsmanagerv1.UpdateSecretOptionsActionRotateConst
+	secretID := sm["secret_id"].(string)
+	updateSecretOptions := &secretsmanagerv1.UpdateSecretOptions{
+		SecretType: &secretType,
+		ID:         &secretID,
+		Action:     &action,
+		SecretActionOneOf: &secretsmanagerv1.SecretActionOneOfRotateArbitrarySecretBody{
+			Payload: &value,
+		},
+	}
+
+	_, response, err := secretsManagerClient.UpdateSecret(updateSecretOptions)
+	if err != nil {
+		return flex.NewAPIError(err, response).WithOperation("UpdateSecret").WithResource("ibm_iam_service_api_key", d.Id())
+	}
+	return nil
+}
+
+func validateAPIKeyRotationDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as 720h: %s", k, err))
+		return
+	}
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got %s", k, v.(string)))
+	}
+	return
+}
+
 func saveToFile(apiKey *iamidentityv1.APIKey, filePath string) error {
 	outputFilePath, err := homedir.Expand(filePath)
 	if err != nil {
@@ -82,6 +82,44 @@ func TestAccIBMIAMServiceAPIKey_import(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServiceAPIKey_rotation(t *testing.T) {
+	var firstID string
+	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraform_iam_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_service_api_key.testacc_apiKey"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServiceAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMServiceAPIKeyID(resourceName, &firstID),
+					resource.TestCheckResourceAttrSet(resourceName, "apikey"),
+					resource.TestCheckResourceAttr(resourceName, "previous_apikey_id", ""),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMServiceAPIKeyRotated(resourceName, &firstID),
+					resource.TestCheckResourceAttrSet(resourceName, "apikey"),
+					resource.TestCheckResourceAttrSet(resourceName, "previous_apikey"),
+					resource.TestCheckResourceAttrSet(resourceName, "previous_apikey_expires_at"),
+					resource.TestCheckResourceAttr(resourceName, "previous_apikey_expired", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_due", "false"),
+				),
+			},
+			{
+				Config:   testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, "2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMServiceAPIKeyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -100,6 +138,16 @@ func testAccCheckIBMIAMServiceAPIKeyDestroy(s *terraform.State) error {
 		if err == nil {
 			return fmt.Errorf("Service API Key Still Exists: %s", rs.Primary.ID)
 		}
+
+		if previousID := rs.Primary.Attributes["previous_apikey_id"]; previousID != "" {
+			getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
+				ID: &previousID,
+			}
+			_, _, err := rsContClient.GetAPIKey(getAPIKeyOptions)
+			if err == nil {
+				return fmt.Errorf("Previous Service API Key Still Exists: %s", previousID)
+			}
+		}
 	}
 
 	return nil
@@ -191,3 +239,60 @@ func testAccCheckIBMIAMServiceAPIKeyImport(serviceName, name string) string {
 	  	}
 	`, serviceName, name)
 }
+
+func testAccCheckIBMIAMServiceAPIKeyID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMServiceAPIKeyRotated(n string, previousID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == *previousID {
+			return fmt.Errorf("Service API Key %s was not rotated", rs.Primary.ID)
+		}
+		if rs.Primary.Attributes["previous_apikey_id"] != *previousID {
+			return fmt.Errorf("Expected previous API key %s, got %s", *previousID, rs.Primary.Attributes["previous_apikey_id"])
+		}
+
+		rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+		getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
+			ID: previousID,
+		}
+		_, _, err = rsContClient.GetAPIKey(getAPIKeyOptions)
+		if err != nil {
+			return fmt.Errorf("Previous Service API Key %s was deleted during the overlap: %s", *previousID, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, trigger string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+		resource "ibm_iam_service_api_key" "testacc_apiKey" {
+			name                    = "%s"
+			iam_service_id          = ibm_iam_service_id.serviceID.iam_id
+			store_value             = true
+			rotation_overlap_period = "1h"
+			rotate_on = {
+				trigger = "%s"
+			}
+		}
+	`, serviceName, name, trigger)
+}
@@ -0,0 +1,413 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Data source to evaluate access questions against a set of policies locally
+func DataSourceIBMIAMPolicySimulation() *schema.Resource {
+	policySchema := iamPolicyDefinitionSchema()
+	policySchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Name of the policy in the results; defaults to policy.<index>",
+	}
+	policySchema["subjects"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "IAM IDs and access group IDs the policy applies to; the policy applies to every subject if not set",
+	}
+
+	return &schema.Resource{
+		Read: dataSourceIBMIAMPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Policy definitions to evaluate",
+				Elem: &schema.Resource{
+					Schema: policySchema,
+				},
+			},
+			"access_group_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of access groups whose existing policies are evaluated",
+			},
+			"iam_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IAM IDs of trusted profiles, users or service IDs whose existing policies are evaluated",
+			},
+			"role_actions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Actions granted by roles; roles that are not listed are looked up for the service of the access check",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service name the role belongs to; the actions apply to every service if not set",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Role name",
+						},
+						"actions": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Actions granted by the role",
+						},
+					},
+				},
+			},
+			"access_check": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Access questions to evaluate",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the access check",
+						},
+						"subject": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IAM ID of the identity",
+						},
+						"access_group_ids": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the access groups the identity is a member of",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Action to check, for example cloud-object-storage.object.get",
+						},
+						"resource_attributes": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Attributes of the resource, for example serviceName, serviceInstance and region",
+						},
+						"resource_tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Access management tags of the resource",
+						},
+						"expect": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{flex.SimulatedAllow, flex.SimulatedDeny}),
+							Description:  "Expected decision; allow or deny",
+						},
+					},
+				},
+			},
+			"fail_on_unexpected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail when a decision differs from the expected decision of its access check",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Decisions of the access checks",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the access check",
+						},
+						"decision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "allow or deny",
+						},
+						"policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the policy that allows the access",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Role of the policy that grants the action",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Explanation of the decision",
+						},
+						"as_expected": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the decision is the expected decision; true if no decision is expected",
+						},
+					},
+				},
+			},
+			"unexpected_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the access checks whose decision differs from the expected decision",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	policies := []flex.SimulatedPolicy{}
+	for i, p := range d.Get("policy").([]interface{}) {
+		policy := flex.PolicyMap(p.(map[string]interface{}))
+		if err := validateIAMPolicyDefinition(policy); err != nil {
+			return err
+		}
+		id := fmt.Sprintf("policy.%d", i)
+		if v, ok := policy.GetOk("id"); ok {
+			id = v.(string)
+		}
+		policies = append(policies, flex.SimulatedPolicy{
+			ID:       id,
+			Subjects: flex.ExpandStringList(policy.Get("subjects").([]interface{})),
+			Roles:    flex.ExpandStringList(policy.Get("roles").([]interface{})),
+			Resources: []iampolicymanagementv1.PolicyResource{
+				{
+					Attributes: flex.GeneratePolicyResourceAttributes(policy),
+					Tags:       flex.SetTags(policy),
+				},
+			},
+		})
+	}
+
+	accessGroupIds := flex.ExpandStringList(d.Get("access_group_ids").([]interface{}))
+	iamIds := flex.ExpandStringList(d.Get("iam_ids").([]interface{}))
+	if len(accessGroupIds) > 0 || len(iamIds) > 0 {
+		existing, err := listSimulationPolicies(meta, accessGroupIds, iamIds)
+		if err != nil {
+			return err
+		}
+		policies = append(policies, existing...)
+	}
+
+	roleActions := simulationRoleActions(d, meta)
+
+	results := make([]map[string]interface{}, 0)
+	unexpected := make([]string, 0)
+	for _, c := range d.Get("access_check").([]interface{}) {
+		check := c.(map[string]interface{})
+		name := check["name"].(string)
+
+		access := flex.SimulatedAccess{
+			Subjects:           append([]string{check["subject"].(string)}, flex.ExpandStringList(check["access_group_ids"].([]interface{}))...),
+			Action:             check["action"].(string),
+			ResourceAttributes: expandSimulationMap(check["resource_attributes"].(map[string]interface{})),
+			ResourceTags:       expandSimulationMap(check["resource_tags"].(map[string]interface{})),
+		}
+		decision, err := flex.SimulateAccess(policies, roleActions, access)
+		if err != nil {
+			return err
+		}
+
+		asExpected := true
+		if expect := check["expect"].(string); expect != "" && expect != decision.Decision {
+			asExpected = false
+			unexpected = append(unexpected, name)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":        name,
+			"decision":    decision.Decision,
+			"policy_id":   decision.PolicyID,
+			"role":        decision.Role,
+			"reason":      decision.Reason,
+			"as_expected": asExpected,
+		})
+	}
+
+	if len(unexpected) > 0 && d.Get("fail_on_unexpected").(bool) {
+		return fmt.Errorf("[ERROR] Access checks with an unexpected decision: %s", strings.Join(unexpected, ", "))
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("results", results)
+	d.Set("unexpected_results", unexpected)
+
+	return nil
+}
+
+// simulationRoleActions returns the actions of a role from role_actions, or
+// looks up the roles of the service once when the role is not listed.
+func simulationRoleActions(d *schema.ResourceData, meta interface{}) flex.RoleActionsFunc {
+	configured := make(map[string]map[string][]string)
+	for _, r := range d.Get("role_actions").([]interface{}) {
+		ra := r.(map[string]interface{})
+		service := ra["service"].(string)
+		if configured[service] == nil {
+			configured[service] = make(map[string][]string)
+		}
+		configured[service][ra["role"].(string)] = flex.ExpandStringList(ra["actions"].([]interface{}))
+	}
+
+	looked := make(map[string]map[string][]string)
+	return func(service, role string) ([]string, error) {
+		if actions, ok := configured[service][role]; ok {
+			return actions, nil
+		}
+		if actions, ok := configured[""][role]; ok {
+			return actions, nil
+		}
+		if service == "" {
+			return nil, nil
+		}
+
+		if _, ok := looked[service]; !ok {
+			roles, err := listSimulationRoleActions(meta, service)
+			if err != nil {
+				return nil, err
+			}
+			looked[service] = roles
+		}
+		return looked[service][role], nil
+	}
+}
+
+func listSimulationRoleActions(meta interface{}, service string) (map[string][]string, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   core.StringPtr(userDetails.UserAccount),
+		ServiceName: core.StringPtr(service),
+	}
+	roleList, resp, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil {
+		return nil, flex.NewAPIError(err, resp).WithOperation("ListRoles").WithResource("ibm_iam_policy_simulation", service)
+	}
+	if roleList == nil {
+		return nil, fmt.Errorf("[ERROR] Error calling ListRoles for ibm_iam_policy_simulation: empty response")
+	}
+
+	roles := make(map[string][]string)
+	for _, role := range append(roleList.SystemRoles, roleList.ServiceRoles...) {
+		if role.DisplayName != nil {
+			roles[*role.DisplayName] = role.Actions
+		}
+	}
+	for _, role := range roleList.CustomRoles {
+		if role.DisplayName != nil {
+			roles[*role.DisplayName] = role.Actions
+		}
+	}
+	return roles, nil
+}
+
+func listSimulationPolicies(meta interface{}, accessGroupIds, iamIds []string) ([]flex.SimulatedPolicy, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]*iampolicymanagementv1.ListPoliciesOptions, 0, len(accessGroupIds)+len(iamIds))
+	for _, id := range accessGroupIds {
+		options = append(options, &iampolicymanagementv1.ListPoliciesOptions{
+			AccountID:     core.StringPtr(userDetails.UserAccount),
+			AccessGroupID: core.StringPtr(id),
+			Type:          core.StringPtr("access"),
+		})
+	}
+	for _, id := range iamIds {
+		options = append(options, &iampolicymanagementv1.ListPoliciesOptions{
+			AccountID: core.StringPtr(userDetails.UserAccount),
+			IamID:     core.StringPtr(id),
+			Type:      core.StringPtr("access"),
+		})
+	}
+
+	policies := []flex.SimulatedPolicy{}
+	for _, listPoliciesOptions := range options {
+		policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+		if err != nil {
+			return nil, flex.NewAPIError(err, resp).WithOperation("ListPolicies").WithResource("ibm_iam_policy_simulation", "")
+		}
+		if policyList == nil {
+			return nil, fmt.Errorf("[ERROR] Error calling ListPolicies for ibm_iam_policy_simulation: empty response")
+		}
+		for _, policy := range policyList.Policies {
+			if policy.State != nil && *policy.State == "deleted" {
+				continue
+			}
+			policies = append(policies, flattenSimulationPolicy(policy))
+		}
+	}
+	return policies, nil
+}
+
+func flattenSimulationPolicy(policy iampolicymanagementv1.Policy) flex.SimulatedPolicy {
+	subjects := []string{}
+	for _, s := range policy.Subjects {
+		for _, a := range s.Attributes {
+			if a.Name != nil && a.Value != nil && (*a.Name == "access_group_id" || *a.Name == "iam_id") {
+				subjects = append(subjects, *a.Value)
+			}
+		}
+	}
+	roles := make([]string, 0, len(policy.Roles))
+	for _, role := range policy.Roles {
+		if role.DisplayName != nil {
+			roles = append(roles, *role.DisplayName)
+		}
+	}
+	return flex.SimulatedPolicy{
+		ID:        *policy.ID,
+		Subjects:  subjects,
+		Roles:     roles,
+		Resources: policy.Resources,
+	}
+}
+
+func expandSimulationMap(m map[string]interface{}) map[string]string {
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[k] = v.(string)
+	}
+	return out
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMPolicySimulationDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicySimulationDataSourceConfig("allow"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_simulation.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_simulation.test", "results.0.decision", "allow"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_simulation.test", "results.0.policy_id", "kms-readers"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_simulation.test", "results.0.role", "Reader"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_simulation.test", "results.1.decision", "deny"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_simulation.test", "unexpected_results.#", "0"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMIAMPolicySimulationDataSourceConfig("deny"),
+				ExpectError: regexp.MustCompile("Access checks with an unexpected decision: list keys"),
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMPolicySimulationDataSource_accessGroup(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicySimulationDataSourceAccessGroup(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_simulation.test", "results.0.decision", "allow"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_policy_simulation.test", "results.0.policy_id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_simulation.test", "results.1.decision", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicySimulationDataSourceConfig(expect string) string {
+	return fmt.Sprintf(`
+		data "ibm_iam_policy_simulation" "test" {
+			policy {
+				id       = "kms-readers"
+				subjects = ["AccessGroupId-readers"]
+				roles    = ["Reader"]
+				resources {
+					service = "kms"
+					region  = "us-south"
+				}
+			}
+
+			role_actions {
+				service = "kms"
+				role    = "Reader"
+				actions = ["kms.secrets.list"]
+			}
+
+			access_check {
+				name             = "list keys"
+				subject          = "IBMid-reader"
+				access_group_ids = ["AccessGroupId-readers"]
+				action           = "kms.secrets.list"
+				resource_attributes = {
+					serviceName = "kms"
+					region      = "us-south"
+				}
+				expect = "%s"
+			}
+
+			access_check {
+				name    = "list keys in another region"
+				subject = "IBMid-reader"
+				action  = "kms.secrets.list"
+				resource_attributes = {
+					serviceName = "kms"
+					region      = "eu-de"
+				}
+				expect = "deny"
+			}
+
+			fail_on_unexpected = true
+		}
+	`, expect)
+}
+
+func testAccCheckIBMIAMPolicySimulationDataSourceAccessGroup(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+		}
+
+		data "ibm_iam_policy_simulation" "test" {
+			access_group_ids = [ibm_iam_access_group_policy.policy.access_group_id]
+
+			access_check {
+				name             = "view kms instance"
+				subject          = "IBMid-member"
+				access_group_ids = [ibm_iam_access_group.accgrp.id]
+				action           = "resource-controller.instance.retrieve"
+				resource_attributes = {
+					serviceName = "kms"
+				}
+			}
+
+			access_check {
+				name             = "view cos instance"
+				subject          = "IBMid-member"
+				access_group_ids = [ibm_iam_access_group.accgrp.id]
+				action           = "resource-controller.instance.retrieve"
+				resource_attributes = {
+					serviceName = "cloud-object-storage"
+				}
+			}
+		}
+	`, name)
+}
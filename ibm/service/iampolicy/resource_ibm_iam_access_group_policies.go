@@ -0,0 +1,570 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMIAMAccessGroupPolicies manages the complete set of access
+// policies of an access group; policies created outside of it are removed.
+func ResourceIBMIAMAccessGroupPolicies() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMIAMAccessGroupPoliciesCreate,
+		Read:     resourceIBMIAMAccessGroupPoliciesRead,
+		Update:   resourceIBMIAMAccessGroupPoliciesUpdate,
+		Delete:   resourceIBMIAMAccessGroupPoliciesDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of access group",
+			},
+
+			"policy": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The complete set of access policies of the access group",
+				Elem: &schema.Resource{
+					Schema: iamPolicyDefinitionSchema(),
+				},
+			},
+
+			"policy_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the access policies of the access group",
+			},
+		},
+	}
+}
+
+// iamPolicyDefinitionSchema is the schema of one policy block; it has the same
+// arguments as ibm_iam_access_group_policy without the access group.
+func iamPolicyDefinitionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"roles": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Role names of the policy definition",
+		},
+
+		"resources": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Resource of the policy definition; conflicts with resource_attributes and account_management",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"service": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Service name of the policy definition",
+					},
+
+					"resource_instance_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "ID of resource instance of the policy definition",
+					},
+
+					"region": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Region of the policy definition",
+					},
+
+					"resource_type": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Resource type of the policy definition",
+					},
+
+					"resource": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Resource of the policy definition",
+					},
+
+					"resource_group_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "ID of the resource group.",
+					},
+
+					"service_type": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Service type of the policy definition",
+					},
+
+					"attributes": {
+						Type:        schema.TypeMap,
+						Optional:    true,
+						Description: "Set resource attributes in the form of 'name=value,name=value....",
+						Elem:        schema.TypeString,
+					},
+				},
+			},
+		},
+
+		"resource_attributes": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Set resource attributes; conflicts with resources and account_management",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of attribute.",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Value of attribute.",
+					},
+					"operator": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "stringEquals",
+						Description: "Operator of attribute.",
+					},
+				},
+			},
+		},
+
+		"account_management": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Give access to all account management services; conflicts with resources and resource_attributes",
+		},
+
+		"resource_tags": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Set access management tags.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of attribute.",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Value of attribute.",
+					},
+					"operator": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "stringEquals",
+						Description: "Operator of attribute.",
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description of the Policy",
+		},
+	}
+}
+
+func resourceIBMIAMAccessGroupPoliciesCreate(d *schema.ResourceData, meta interface{}) error {
+	accessGroupId := d.Get("access_group_id").(string)
+
+	err := reconcileAccessGroupPolicies(d, meta, accessGroupId)
+	if err != nil {
+		return err
+	}
+	d.SetId(accessGroupId)
+
+	return resourceIBMIAMAccessGroupPoliciesRead(d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	accessGroupId := d.Id()
+	policies, err := listAccessGroupPolicies(d, meta, iamPolicyManagementClient, accessGroupId)
+	if err != nil {
+		return err
+	}
+
+	// Live policies are matched to the configured policy blocks so that the
+	// configured form (resources, resource_attributes or account_management)
+	// is kept; any other policy of the group is flattened and shows as drift
+	configured := make(map[string][]map[string]interface{})
+	if v, ok := d.GetOk("policy"); ok {
+		for _, p := range v.(*schema.Set).List() {
+			policy := p.(map[string]interface{})
+			key := accessGroupPolicyConfigKey(flex.PolicyMap(policy))
+			configured[key] = append(configured[key], policy)
+		}
+	}
+
+	policyList := make([]map[string]interface{}, 0, len(policies))
+	policyIds := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		policyIds = append(policyIds, *policy.ID)
+
+		key := accessGroupPolicyKey(policy)
+		if matches := configured[key]; len(matches) > 0 {
+			configured[key] = matches[1:]
+			p := make(map[string]interface{}, len(matches[0]))
+			for k, v := range matches[0] {
+				p[k] = v
+			}
+			p["description"] = accessGroupPolicyString(policy.Description, "")
+			policyList = append(policyList, p)
+			continue
+		}
+
+		log.Printf("[DEBUG] Policy %s of access group %s is not in the configuration", *policy.ID, accessGroupId)
+		policyList = append(policyList, flattenAccessGroupPoliciesPolicy(policy))
+	}
+
+	d.Set("access_group_id", accessGroupId)
+	if err := d.Set("policy", policyList); err != nil {
+		return fmt.Errorf("[ERROR] Error setting policy: %s", err)
+	}
+	d.Set("policy_ids", policyIds)
+
+	return nil
+}
+
+func resourceIBMIAMAccessGroupPoliciesUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("policy") {
+		err := reconcileAccessGroupPolicies(d, meta, d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMIAMAccessGroupPoliciesRead(d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	policies, err := listAccessGroupPolicies(d, meta, iamPolicyManagementClient, d.Id())
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		res, err := iamPolicyManagementClient.DeletePolicy(iamPolicyManagementClient.NewDeletePolicyOptions(*policy.ID))
+		if err != nil && (res == nil || res.StatusCode != 404) {
+			return flex.NewAPIError(err, res).WithOperation("DeletePolicy").WithResource("ibm_iam_access_group_policies", d.Id())
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// reconcileAccessGroupPolicies creates the configured policies that do not
+// exist yet, updates the description of matching ones and deletes all other
+// access policies of the group.
+func reconcileAccessGroupPolicies(d *schema.ResourceData, meta interface{}, accessGroupId string) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	desired := []flex.PolicyMap{}
+	if v, ok := d.GetOk("policy"); ok {
+		for _, p := range v.(*schema.Set).List() {
+			policy := flex.PolicyMap(p.(map[string]interface{}))
+			if err := validateIAMPolicyDefinition(policy); err != nil {
+				return err
+			}
+			desired = append(desired, policy)
+		}
+	}
+
+	policies, err := listAccessGroupPolicies(d, meta, iamPolicyManagementClient, accessGroupId)
+	if err != nil {
+		return err
+	}
+	unmatched := make(map[string][]iampolicymanagementv1.Policy)
+	for _, policy := range policies {
+		key := accessGroupPolicyKey(policy)
+		unmatched[key] = append(unmatched[key], policy)
+	}
+
+	for _, policy := range desired {
+		key := accessGroupPolicyConfigKey(policy)
+		if matches := unmatched[key]; len(matches) > 0 {
+			existing := matches[0]
+			unmatched[key] = matches[1:]
+			if accessGroupPolicyString(existing.Description, "") != policy.Get("description").(string) {
+				err = updateAccessGroupPoliciesPolicy(meta, iamPolicyManagementClient, accessGroupId, *existing.ID, policy)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		err = createAccessGroupPoliciesPolicy(meta, iamPolicyManagementClient, accessGroupId, policy)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, matches := range unmatched {
+		for _, policy := range matches {
+			log.Printf("[INFO] Deleting policy %s of access group %s as it is not in the configuration", *policy.ID, accessGroupId)
+			res, err := iamPolicyManagementClient.DeletePolicy(iamPolicyManagementClient.NewDeletePolicyOptions(*policy.ID))
+			if err != nil && (res == nil || res.StatusCode != 404) {
+				return flex.NewAPIError(err, res).WithOperation("DeletePolicy").WithResource("ibm_iam_access_group_policies", accessGroupId)
+			}
+		}
+	}
+
+	return nil
+}
+
+func createAccessGroupPoliciesPolicy(meta interface{}, iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, accessGroupId string, policy flex.PolicyMap) error {
+	subjects, roles, resources, err := generateAccessGroupPoliciesPolicyOptions(meta, accessGroupId, policy)
+	if err != nil {
+		return err
+	}
+
+	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions("access", subjects, roles, resources)
+	if desc, ok := policy.GetOk("description"); ok {
+		createPolicyOptions.Description = core.StringPtr(desc.(string))
+	}
+
+	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	if err != nil {
+		return flex.NewAPIError(err, res).WithOperation("CreatePolicy").WithResource("ibm_iam_access_group_policies", accessGroupId)
+	}
+	if accessGroupPolicy == nil {
+		return fmt.Errorf("[ERROR] Error calling CreatePolicy for ibm_iam_access_group_policies: empty response")
+	}
+
+	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
+		PolicyID: accessGroupPolicy.ID,
+	}
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err != nil || policy == nil {
+			if res != nil && res.StatusCode == 404 {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	}
+	if err != nil {
+		return flex.NewAPIError(err, res).WithOperation("GetPolicy").WithResource("ibm_iam_access_group_policies", accessGroupId)
+	}
+
+	return nil
+}
+
+func updateAccessGroupPoliciesPolicy(meta interface{}, iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, accessGroupId, policyId string, policy flex.PolicyMap) error {
+	_, res, err := iamPolicyManagementClient.GetPolicy(iamPolicyManagementClient.NewGetPolicyOptions(policyId))
+	if err != nil {
+		return flex.NewAPIError(err, res).WithOperation("GetPolicy").WithResource("ibm_iam_access_group_policies", accessGroupId)
+	}
+
+	subjects, roles, resources, err := generateAccessGroupPoliciesPolicyOptions(meta, accessGroupId, policy)
+	if err != nil {
+		return err
+	}
+
+	updatePolicyOptions := iamPolicyManagementClient.NewUpdatePolicyOptions(
+		policyId,
+		res.Headers.Get("ETag"),
+		"access",
+		subjects,
+		roles,
+		resources,
+	)
+	updatePolicyOptions.Description = core.StringPtr(policy.Get("description").(string))
+
+	_, res, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+	if err != nil {
+		return flex.NewAPIError(err, res).WithOperation("UpdatePolicy").WithResource("ibm_iam_access_group_policies", accessGroupId)
+	}
+
+	return nil
+}
+
+func generateAccessGroupPoliciesPolicyOptions(meta interface{}, accessGroupId string, policy flex.PolicyMap) ([]iampolicymanagementv1.PolicySubject, []iampolicymanagementv1.PolicyRole, []iampolicymanagementv1.PolicyResource, error) {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	policyOptions, err := flex.GeneratePolicyOptions(policy, meta)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	accessGroupIdSubject := iampolicymanagementv1.PolicySubject{
+		Attributes: []iampolicymanagementv1.SubjectAttribute{
+			{
+				Name:  core.StringPtr("access_group_id"),
+				Value: &accessGroupId,
+			},
+		},
+	}
+
+	accountIdResourceAttribute := iampolicymanagementv1.ResourceAttribute{
+		Name:  core.StringPtr("accountId"),
+		Value: &userDetails.UserAccount,
+	}
+
+	policyResource := iampolicymanagementv1.PolicyResource{
+		Attributes: append(policyOptions.Resources[0].Attributes, accountIdResourceAttribute),
+		Tags:       flex.SetTags(policy),
+	}
+
+	return []iampolicymanagementv1.PolicySubject{accessGroupIdSubject}, policyOptions.Roles, []iampolicymanagementv1.PolicyResource{policyResource}, nil
+}
+
+func listAccessGroupPolicies(d *schema.ResourceData, meta interface{}, iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, accessGroupId string) ([]iampolicymanagementv1.Policy, error) {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID:     core.StringPtr(userDetails.UserAccount),
+		AccessGroupID: core.StringPtr(accessGroupId),
+		Type:          core.StringPtr("access"),
+	}
+
+	policyList, res, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	if err != nil {
+		return nil, flex.NewAPIError(err, res).WithOperation("ListPolicies").WithResource("ibm_iam_access_group_policies", d.Id())
+	}
+	if policyList == nil {
+		return nil, fmt.Errorf("[ERROR] Error calling ListPolicies for ibm_iam_access_group_policies: empty response")
+	}
+
+	policies := make([]iampolicymanagementv1.Policy, 0, len(policyList.Policies))
+	for _, policy := range policyList.Policies {
+		if policy.State != nil && *policy.State == "deleted" {
+			continue
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+func validateIAMPolicyDefinition(policy flex.PolicyMap) error {
+	count := 0
+	for _, k := range []string{"resources", "resource_attributes", "account_management"} {
+		if _, ok := policy.GetOk(k); ok {
+			count++
+		}
+	}
+	if count > 1 {
+		return fmt.Errorf("[ERROR] Only one of resources, resource_attributes or account_management can be set in a policy with roles %v", policy.Get("roles"))
+	}
+	return nil
+}
+
+func flattenAccessGroupPoliciesPolicy(policy iampolicymanagementv1.Policy) map[string]interface{} {
+	roles := make([]string, len(policy.Roles))
+	for i, role := range policy.Roles {
+		roles[i] = accessGroupPolicyString(role.DisplayName, "")
+	}
+	return map[string]interface{}{
+		"roles":         roles,
+		"resources":     flex.FlattenPolicyResource(policy.Resources),
+		"resource_tags": flex.FlattenPolicyResourceTags(policy.Resources),
+		"description":   accessGroupPolicyString(policy.Description, ""),
+	}
+}
+
+// accessGroupPolicyKey identifies a live policy by its roles, resource
+// attributes and tags; the description is not part of the key so that it can
+// be updated in place.
+func accessGroupPolicyKey(policy iampolicymanagementv1.Policy) string {
+	roles := make([]string, 0, len(policy.Roles))
+	for _, role := range policy.Roles {
+		roles = append(roles, accessGroupPolicyString(role.DisplayName, ""))
+	}
+	attributes := []iampolicymanagementv1.ResourceAttribute{}
+	tags := []iampolicymanagementv1.ResourceTag{}
+	for _, r := range policy.Resources {
+		attributes = append(attributes, r.Attributes...)
+		tags = append(tags, r.Tags...)
+	}
+	return generateAccessGroupPolicyKey(roles, attributes, tags)
+}
+
+// accessGroupPolicyConfigKey identifies a configured policy block the same way
+// as accessGroupPolicyKey does for live policies.
+func accessGroupPolicyConfigKey(policy flex.PolicyMap) string {
+	roles := flex.ExpandStringList(policy.Get("roles").([]interface{}))
+	return generateAccessGroupPolicyKey(roles, flex.GeneratePolicyResourceAttributes(policy), flex.SetTags(policy))
+}
+
+func generateAccessGroupPolicyKey(roles []string, attributes []iampolicymanagementv1.ResourceAttribute, tags []iampolicymanagementv1.ResourceTag) string {
+	sortedRoles := append([]string{}, roles...)
+	sort.Strings(sortedRoles)
+
+	attrs := make([]string, 0, len(attributes))
+	for _, a := range attributes {
+		name := accessGroupPolicyString(a.Name, "")
+		if name == "accountId" {
+			continue
+		}
+		attrs = append(attrs, fmt.Sprintf("%s:%s:%s", name, accessGroupPolicyString(a.Operator, "stringEquals"), accessGroupPolicyString(a.Value, "")))
+	}
+	sort.Strings(attrs)
+
+	t := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		t = append(t, fmt.Sprintf("%s:%s:%s", accessGroupPolicyString(tag.Name, ""), accessGroupPolicyString(tag.Operator, "stringEquals"), accessGroupPolicyString(tag.Value, "")))
+	}
+	sort.Strings(t)
+
+	return strings.Join(sortedRoles, ",") + "|" + strings.Join(attrs, ",") + "|" + strings.Join(t, ",")
+}
+
+func accessGroupPolicyString(s *string, def string) string {
+	if s == nil || *s == "" {
+		return def
+	}
+	return *s
+}
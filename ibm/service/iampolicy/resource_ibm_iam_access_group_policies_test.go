@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAccessGroupPolicies_Basic(t *testing.T) {
+	var accessGroupId string
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMAccessGroupPoliciesID("ibm_iam_access_group_policies.policies", &accessGroupId),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy_ids.#", "2"),
+				),
+			},
+			{
+				// A policy created outside of Terraform shows as drift
+				PreConfig:          func() { testAccIBMIAMAccessGroupPoliciesCreateOutOfBand(t, accessGroupId) },
+				Config:             testAccCheckIBMIAMAccessGroupPoliciesBasic(name),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesUpdate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_access_group_policies.policies",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported policies are read in the resources form
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesDestroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_access_group_policies" {
+			continue
+		}
+
+		listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+			AccountID:     core.StringPtr(userDetails.UserAccount),
+			AccessGroupID: core.StringPtr(rs.Primary.ID),
+			Type:          core.StringPtr("access"),
+		}
+		policyList, response, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error listing policies of access group %s: %s", rs.Primary.ID, err)
+		}
+		for _, policy := range policyList.Policies {
+			if policy.State == nil || *policy.State != "deleted" {
+				return fmt.Errorf("Access group policy still exists: %s/%s\n", rs.Primary.ID, *policy.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesID(n string, accessGroupId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		*accessGroupId = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccIBMIAMAccessGroupPoliciesCreateOutOfBand(t *testing.T, accessGroupId string) {
+	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
+		"access",
+		[]iampolicymanagementv1.PolicySubject{
+			{
+				Attributes: []iampolicymanagementv1.SubjectAttribute{
+					{Name: core.StringPtr("access_group_id"), Value: core.StringPtr(accessGroupId)},
+				},
+			},
+		},
+		[]iampolicymanagementv1.PolicyRole{
+			{RoleID: core.StringPtr("crn:v1:bluemix:public:iam::::role:Editor")},
+		},
+		[]iampolicymanagementv1.PolicyResource{
+			{
+				Attributes: []iampolicymanagementv1.ResourceAttribute{
+					{Name: core.StringPtr("accountId"), Value: core.StringPtr(userDetails.UserAccount)},
+					{Name: core.StringPtr("serviceName"), Value: core.StringPtr("kms"), Operator: core.StringPtr("stringEquals")},
+				},
+			},
+		},
+	)
+	_, _, err = iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesBasic(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policies" "policies" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+
+			policy {
+				roles = ["Viewer"]
+			}
+
+			policy {
+				roles       = ["Viewer", "Manager"]
+				description = "kms access"
+				resources {
+					service = "kms"
+				}
+			}
+		}
+	`, name)
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesUpdate(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policies" "policies" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+
+			policy {
+				roles       = ["Viewer", "Manager"]
+				description = "kms access"
+				resources {
+					service = "kms"
+				}
+			}
+		}
+	`, name)
+}
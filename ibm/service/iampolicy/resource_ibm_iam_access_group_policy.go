@@ -252,8 +252,11 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	}
 
 	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
-	if err != nil || accessGroupPolicy == nil {
-		return fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, res)
+	if err != nil {
+		return flex.NewAPIError(err, res).WithOperation("CreatePolicy").WithResource("ibm_iam_access_group_policy", d.Id())
+	}
+	if accessGroupPolicy == nil {
+		return fmt.Errorf("[ERROR] Error calling CreatePolicy for ibm_iam_access_group_policy: empty response")
 	}
 
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
@@ -281,7 +284,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
-		return fmt.Errorf("[ERROR] Error fetching access group policy: %s\n%s", err, res)
+		return flex.NewAPIError(err, res).WithOperation("GetPolicy").WithResource("ibm_iam_access_group_policy", d.Id())
 	}
 	d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
 
@@ -327,8 +330,11 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	if conns.IsResourceTimeoutError(err) {
 		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
-	if err != nil || accessGroupPolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
+	if err != nil {
+		return flex.NewAPIError(err, res).WithOperation("GetPolicy").WithResource("ibm_iam_access_group_policy", d.Id())
+	}
+	if accessGroupPolicy == nil || res == nil {
+		return fmt.Errorf("[ERROR] Error calling GetPolicy for ibm_iam_access_group_policy: empty response")
 	}
 
 	retrievedAttribute := flex.GetSubjectAttribute("access_group_id", accessGroupPolicy.Subjects[0])
@@ -439,7 +445,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 
 		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating access group policy: %s\n%s", err, res)
+			return flex.NewAPIError(err, res).WithOperation("UpdatePolicy").WithResource("ibm_iam_access_group_policy", d.Id())
 		}
 	}
 
@@ -469,7 +475,7 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 
 	res, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting access group policy: %s\n%s", err, res)
+		return flex.NewAPIError(err, res).WithOperation("DeletePolicy").WithResource("ibm_iam_access_group_policy", d.Id())
 	}
 
 	d.SetId("")
@@ -501,7 +507,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting access group policy: %s\n%s", err, resp)
+		return false, flex.NewAPIError(err, resp).WithOperation("GetPolicy").WithResource("ibm_iam_access_group_policy", d.Id())
 	}
 
 	if accessGroupPolicy != nil && accessGroupPolicy.State != nil && *accessGroupPolicy.State == "deleted" {
@@ -531,7 +537,7 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 
 	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
+		return nil, nil, flex.NewAPIError(err, res).WithOperation("GetPolicy").WithResource("ibm_iam_access_group_policy", d.Id())
 	}
 
 	resources := flex.FlattenPolicyResource(accessGroupPolicy.Resources)
@@ -215,7 +215,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		}
 		serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+			return flex.NewAPIError(err, resp).WithOperation("GetServiceID").WithResource("ibm_iam_service_policy", d.Id())
 		}
 		iamID = *serviceID.IamID
 	}
@@ -276,7 +276,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res)
+		return flex.NewAPIError(err, res).WithOperation("CreatePolicy").WithResource("ibm_iam_service_policy", d.Id())
 	}
 
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
@@ -311,7 +311,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 			iamID := v.(string)
 			d.SetId(fmt.Sprintf("%s/%s", iamID, *servicePolicy.ID))
 		}
-		return fmt.Errorf("[ERROR] Error fetching service  policy: %s %s", err, res)
+		return flex.NewAPIError(err, res).WithOperation("GetPolicy").WithResource("ibm_iam_service_policy", d.Id())
 	}
 	if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
 		serviceIDUUID := v.(string)
@@ -363,8 +363,11 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	if conns.IsResourceTimeoutError(err) {
 		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
-	if err != nil || servicePolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res)
+	if err != nil {
+		return flex.NewAPIError(err, res).WithOperation("GetPolicy").WithResource("ibm_iam_service_policy", d.Id())
+	}
+	if servicePolicy == nil || res == nil {
+		return fmt.Errorf("[ERROR] Error calling GetPolicy for ibm_iam_service_policy: empty response")
 	}
 	if strings.HasPrefix(serviceIDUUID, "iam-") {
 		d.Set("iam_id", serviceIDUUID)
@@ -436,7 +439,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+				return flex.NewAPIError(err, resp).WithOperation("GetServiceID").WithResource("ibm_iam_service_policy", d.Id())
 			}
 			iamID = *serviceID.IamID
 		}
@@ -491,7 +494,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("GetPolicy").WithResource("ibm_iam_service_policy", d.Id())
 		}
 
 		servicePolicyETag := response.Headers.Get("ETag")
@@ -512,9 +515,9 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 		if transactionID, ok := d.GetOk("transaction_id"); ok {
 			updatePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 		}
-		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, response, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating service policy: %s", err)
+			return flex.NewAPIError(err, response).WithOperation("UpdatePolicy").WithResource("ibm_iam_service_policy", d.Id())
 		}
 
 	}
@@ -543,9 +546,9 @@ func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{})
 		deletePolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	response, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting service policy: %s", err)
+		return flex.NewAPIError(err, response).WithOperation("DeletePolicy").WithResource("ibm_iam_service_policy", d.Id())
 	}
 
 	d.SetId("")
@@ -577,7 +580,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting service policy: %s\n%s", err, resp)
+		return false, flex.NewAPIError(err, resp).WithOperation("GetPolicy").WithResource("ibm_iam_service_policy", d.Id())
 	}
 
 	if servicePolicy != nil && servicePolicy.State != nil && *servicePolicy.State == "deleted" {
@@ -603,9 +606,9 @@ func importServicePolicy(d *schema.ResourceData, meta interface{}) (interface{},
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		servicePolicyID,
 	)
-	servicePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	servicePolicy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s", err)
+		return nil, nil, flex.NewAPIError(err, response).WithOperation("GetPolicy").WithResource("ibm_iam_service_policy", d.Id())
 	}
 	resources := flex.FlattenPolicyResource(servicePolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(servicePolicy.Resources)
@@ -255,7 +255,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 
 	userPolicy, resp, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
+		return flex.NewAPIError(err, resp).WithOperation("CreatePolicy").WithResource("ibm_iam_user_policy", d.Id())
 	}
 
 	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
@@ -331,8 +331,11 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if conns.IsResourceTimeoutError(err) {
 		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	}
-	if err != nil || userPolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving userPolicy: %s %s", err, res)
+	if err != nil {
+		return flex.NewAPIError(err, res).WithOperation("GetPolicy").WithResource("ibm_iam_user_policy", d.Id())
+	}
+	if userPolicy == nil || res == nil {
+		return fmt.Errorf("[ERROR] Error calling GetPolicy for ibm_iam_user_policy: empty response")
 	}
 	d.Set("ibm_id", userEmail)
 	roles := make([]string, len(userPolicy.Roles))
@@ -432,7 +435,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("GetPolicy").WithResource("ibm_iam_user_policy", d.Id())
 		}
 
 		userPolicyETag := response.Headers.Get("ETag")
@@ -456,7 +459,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 
 		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
+			return flex.NewAPIError(err, resp).WithOperation("UpdatePolicy").WithResource("ibm_iam_user_policy", d.Id())
 		}
 	}
 	return resourceIBMIAMUserPolicyRead(d, meta)
@@ -516,7 +519,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting user policy: %s\n%s", err, resp)
+		return false, flex.NewAPIError(err, resp).WithOperation("GetPolicy").WithResource("ibm_iam_user_policy", d.Id())
 	}
 
 	if userPolicy != nil && userPolicy.State != nil && *userPolicy.State == "deleted" {
@@ -543,9 +546,9 @@ func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, in
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		userPolicyID,
 	)
-	userPolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	userPolicy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving User Policy: %s", err)
+		return nil, nil, flex.NewAPIError(err, response).WithOperation("GetPolicy").WithResource("ibm_iam_user_policy", d.Id())
 	}
 	resources := flex.FlattenPolicyResource(userPolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(userPolicy.Resources)
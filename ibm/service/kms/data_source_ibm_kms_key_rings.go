@@ -84,7 +84,7 @@ func dataSourceIBMKMSKeyRingsRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(api, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, api, endpointType, extensions)
 	if err != nil {
 		return err
 	}
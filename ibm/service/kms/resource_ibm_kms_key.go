@@ -188,7 +188,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
 		return err
 	}
@@ -286,7 +286,7 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	extensions := instanceData.Extensions
 
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
 		return err
 	}
@@ -381,7 +381,7 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
 		return err
 	}
@@ -427,7 +427,7 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 		return false, fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
 	}
 	extensions := instanceData.Extensions
-	URL, err := KmsEndpointURL(kpAPI, endpointType, extensions)
+	URL, err := KmsEndpointURL(meta, kpAPI, endpointType, extensions)
 	if err != nil {
 		return false, err
 	}
@@ -447,7 +447,7 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 }
 
 //Construct KMS URL
-func KmsEndpointURL(kpAPI *kp.Client, endpointType string, extensions map[string]interface{}) (*url.URL, error) {
+func KmsEndpointURL(meta interface{}, kpAPI *kp.Client, endpointType string, extensions map[string]interface{}) (*url.URL, error) {
 
 	exturl := extensions["endpoints"].(map[string]interface{})["public"]
 	if endpointType == "private" || strings.Contains(kpAPI.Config.BaseURL, "private") {
@@ -455,7 +455,7 @@ func KmsEndpointURL(kpAPI *kp.Client, endpointType string, extensions map[string
 	}
 	endpointURL := fmt.Sprintf("%s/api/v2/keys", exturl.(string))
 
-	url1 := conns.EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, endpointURL)
+	url1 := meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_KP_API_ENDPOINT", endpointURL)
 	u, err := url.Parse(url1)
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] Error Parsing KMS EndpointURL")
@@ -4,6 +4,8 @@
 package kubernetes
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log"
 	"path/filepath"
@@ -11,11 +13,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/ghodss/yaml"
+	"github.com/golang-jwt/jwt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 )
@@ -118,6 +124,30 @@ func DataSourceIBMContainerClusterConfig() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"in_memory": {
+				Description:   "If set to true the config is not written to config_dir and is returned in kube_config instead",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"config_dir", "network"},
+			},
+			"kube_config": {
+				Description: "The kubernetes config yml, when in_memory is set to true",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"token_expiration": {
+				Description: "The time the token expires, when it is a short-lived IAM token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"exec_credential": {
+				Description: "The token and certificates as a client.authentication.k8s.io ExecCredential JSON, for exec-based authentication",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 		},
 	}
 }
@@ -134,9 +164,16 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	configDir := d.Get("config_dir").(string)
 	network := d.Get("network").(bool)
 
+	if d.Get("in_memory").(bool) {
+		return dataSourceIBMContainerClusterConfigReadInMemory(d, meta)
+	}
+
 	clusterId := "Cluster_Config_" + name
-	conns.IbmMutexKV.Lock(clusterId)
-	defer conns.IbmMutexKV.Unlock(clusterId)
+	unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutRead), clusterId)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
@@ -232,3 +269,209 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	d.Set("config_dir", configDir)
 	return nil
 }
+
+// dataSourceIBMContainerClusterConfigReadInMemory gets the cluster config as a
+// single yml, without writing it or the certificates to disk
+func dataSourceIBMContainerClusterConfigReadInMemory(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("cluster_name_id").(string)
+	admin := d.Get("admin").(bool)
+
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	var config []byte
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		config, err = getClusterConfigYAML(meta, name, admin, targetEnv)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+				return resource.RetryableError(err)
+			}
+			if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		config, err = getClusterConfigYAML(meta, name, admin, targetEnv)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the cluster config [%s]: %s", name, err)
+	}
+
+	clusterKeyDetails, err := parseClusterConfigYAML(config)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the cluster config [%s]: %s", name, err)
+	}
+	var expiration string
+	if token, _, err := new(jwt.Parser).ParseUnverified(clusterKeyDetails.Token, jwt.MapClaims{}); err == nil {
+		if exp, ok := token.Claims.(jwt.MapClaims)["exp"].(float64); ok {
+			expiration = time.Unix(int64(exp), 0).UTC().Format(time.RFC3339)
+		}
+	}
+	execCredential, err := clusterConfigExecCredential(clusterKeyDetails, expiration)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	d.Set("kube_config", string(config))
+	d.Set("admin_key", clusterKeyDetails.AdminKey)
+	d.Set("admin_certificate", clusterKeyDetails.Admin)
+	d.Set("ca_certificate", clusterKeyDetails.ClusterCACertificate)
+	d.Set("host", clusterKeyDetails.Host)
+	d.Set("token", clusterKeyDetails.Token)
+	d.Set("token_expiration", expiration)
+	d.Set("exec_credential", execCredential)
+	d.Set("config_file_path", "")
+	return nil
+}
+
+// getClusterConfigYAML gets the cluster config as a single yml, with the
+// certificates inline. The token of OpenShift clusters is added to it like
+// GetClusterConfigDetail does.
+func getClusterConfigYAML(meta interface{}, name string, admin bool, targetEnv v2.ClusterTargetHeader) ([]byte, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+	bmxSess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+
+	path := `/v1/clusters/{idOrName}/config`
+	if admin {
+		path += `/admin`
+	}
+	builder := core.NewRequestBuilder(core.GET)
+	builder.EnableGzipCompression = satClient.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(satClient.Service.Options.URL, path, map[string]string{"idOrName": name})
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("X-Auth-Refresh-Token", bmxSess.Config.IAMRefreshToken)
+	if targetEnv.ResourceGroup != "" {
+		builder.AddHeader("X-Auth-Resource-Group", targetEnv.ResourceGroup)
+	}
+	builder.AddQuery("format", "yaml")
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	var config []byte
+	if _, err = satClient.Service.Request(request, &config); err != nil {
+		return nil, err
+	}
+
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	cls, err := csClient.Clusters().GetCluster(name, targetEnv)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", name, err)
+	}
+	if cls.Type == "openshift" && cls.Provider != "satellite" {
+		oc, ok := csClient.Clusters().(interface {
+			FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *v2.ClusterInfo, skipSSLVerification bool) ([]byte, error)
+		})
+		if ok {
+			return oc.FetchOCTokenForKubeConfig(config, cls, cls.IsStagingSatelliteCluster())
+		}
+	}
+	return config, nil
+}
+
+// clusterKubeConfig is the part of a kubernetes config yml with the host, token
+// and certificates of the cluster
+type clusterKubeConfig struct {
+	Clusters []struct {
+		Cluster struct {
+			Server                   string `json:"server"`
+			CertificateAuthorityData string `json:"certificate-authority-data"`
+		} `json:"cluster"`
+	} `json:"clusters"`
+	Users []struct {
+		Name string `json:"name"`
+		User struct {
+			Token                 string `json:"token"`
+			ClientCertificateData string `json:"client-certificate-data"`
+			ClientKeyData         string `json:"client-key-data"`
+			AuthProvider          struct {
+				Config struct {
+					IDToken string `json:"id-token"`
+				} `json:"config"`
+			} `json:"auth-provider"`
+		} `json:"user"`
+	} `json:"users"`
+}
+
+func parseClusterConfigYAML(config []byte) (v1.ClusterKeyInfo, error) {
+	clusterKeyDetails := v1.ClusterKeyInfo{}
+	var kubeConfig clusterKubeConfig
+	if err := yaml.Unmarshal(config, &kubeConfig); err != nil {
+		return clusterKeyDetails, err
+	}
+	if len(kubeConfig.Clusters) != 0 {
+		cluster := kubeConfig.Clusters[0].Cluster
+		clusterKeyDetails.Host = cluster.Server
+		ca, err := base64.StdEncoding.DecodeString(cluster.CertificateAuthorityData)
+		if err != nil {
+			return clusterKeyDetails, err
+		}
+		clusterKeyDetails.ClusterCACertificate = string(ca)
+	}
+	for _, user := range kubeConfig.Users {
+		switch {
+		case user.User.AuthProvider.Config.IDToken != "":
+			clusterKeyDetails.Token = user.User.AuthProvider.Config.IDToken
+		case user.User.Token != "" && (clusterKeyDetails.Token == "" || strings.HasPrefix(user.Name, "IAM")):
+			clusterKeyDetails.Token = user.User.Token
+		}
+		if user.User.ClientCertificateData != "" {
+			cert, err := base64.StdEncoding.DecodeString(user.User.ClientCertificateData)
+			if err != nil {
+				return clusterKeyDetails, err
+			}
+			key, err := base64.StdEncoding.DecodeString(user.User.ClientKeyData)
+			if err != nil {
+				return clusterKeyDetails, err
+			}
+			clusterKeyDetails.Admin = string(cert)
+			clusterKeyDetails.AdminKey = string(key)
+		}
+	}
+	return clusterKeyDetails, nil
+}
+
+// clusterConfigExecCredential returns the ExecCredential that an exec
+// credential plugin of kubectl or of the kubernetes and helm providers returns
+func clusterConfigExecCredential(clusterKeyDetails v1.ClusterKeyInfo, expiration string) (string, error) {
+	status := map[string]interface{}{}
+	if clusterKeyDetails.Token != "" {
+		status["token"] = clusterKeyDetails.Token
+	}
+	if clusterKeyDetails.Admin != "" {
+		status["clientCertificateData"] = clusterKeyDetails.Admin
+		status["clientKeyData"] = clusterKeyDetails.AdminKey
+	}
+	if expiration != "" {
+		status["expirationTimestamp"] = expiration
+	}
+	execCredential, err := json.Marshal(map[string]interface{}{
+		"apiVersion": "client.authentication.k8s.io/v1beta1",
+		"kind":       "ExecCredential",
+		"status":     status,
+	})
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error marshalling the exec credential: %s", err)
+	}
+	return string(execCredential), nil
+}
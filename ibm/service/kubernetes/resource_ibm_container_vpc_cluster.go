@@ -25,6 +25,11 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
+const (
+	workerUpdatePause    = "pause"
+	workerUpdateContinue = "continue"
+)
+
 const (
 	deployRequested    = "Deploy requested"
 	deployInProgress   = "Deploy in progress"
@@ -45,9 +50,13 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			resourceIBMContainerVpcClusterWorkerUpdateDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +176,64 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			"worker_update_strategy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The strategy to replace the worker nodes when the worker nodes are updated",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "1",
+							ValidateFunc: validateWorkerUpdateMaxUnavailable,
+							Description:  "The number of worker nodes of a worker pool, or the percentage of them such as 25%, that are replaced at the same time",
+						},
+						"worker_pool_order": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the worker pools in the order their worker nodes are replaced. The worker pools that are not listed are replaced after, in the order of their names",
+						},
+						"on_failure": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      workerUpdatePause,
+							ValidateFunc: validate.InvokeValidator("ibm_container_vpc_cluster", "on_failure"),
+							Description:  "Whether the update pauses or continues with the next worker nodes when worker nodes fail to be replaced",
+						},
+					},
+				},
+			},
+
+			"worker_update_progress": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The progress of the last update of the worker nodes. The next apply continues the update with the pending and failed worker nodes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The kube version of the master the worker nodes are updated to",
+						},
+						"pending_workers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the worker nodes that are not replaced yet",
+						},
+						"failed_workers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the worker nodes that failed to be replaced",
+						},
+					},
+				},
+			},
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -240,6 +307,13 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 
 			"wait_till": {
 				Type:             schema.TypeString,
@@ -444,7 +518,13 @@ func ResourceIBMContainerVpcClusterValidator() *validate.ResourceValidator {
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
-			AllowedValues:              tainteffects})
+			AllowedValues:              tainteffects},
+		validate.ValidateSchema{
+			Identifier:                 "on_failure",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              workerUpdatePause + "," + workerUpdateContinue})
 
 	ibmContainerVpcClusteresourceValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_cluster", Schema: validateSchema}
 	return &ibmContainerVpcClusteresourceValidator
@@ -649,7 +729,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	}
 
-	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || d.HasChange("worker_update_progress")) && !d.IsNewResource() {
 
 		if d.HasChange("kube_version") {
 			ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
@@ -697,70 +777,15 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		}
 
 		// Update the worker nodes after master node kube-version is updated.
-		// workers will store the existing workers info to identify the replaced node
-		workersInfo := make(map[string]int)
-
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
-
-			// patchVersion := d.Get("patch_version").(string)
-			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || d.HasChange("worker_update_progress") {
+			err := updateVpcClusterWorkers(d, meta, csClient, targetEnv, cls.MasterKubeVersion)
 			if err != nil {
-				d.Set("patch_version", nil)
-				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
-			}
-
-			for index, worker := range workers {
-				workersInfo[worker.ID] = index
-			}
-			workersCount := len(workers)
-
-			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
-
-			for _, worker := range workers {
-				// check if change is present in MAJOR.MINOR version or in PATCH version
-				if worker.KubeVersion.Actual != worker.KubeVersion.Target {
-					_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
-					// As API returns http response 204 NO CONTENT, error raised will be exempted.
-					if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
-						d.Set("patch_version", nil)
-						return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
-					}
-
-					if waitForWorkerUpdate {
-						//1. wait for worker node to delete
-						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
-						if deleteError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
-						}
-
-						//2. wait for new workerNode
-						_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
-						if newWorkerError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Failed to spawn new worker node")
-						}
-
-						//3. Get new worker node ID and update the map
-						newWorkerID, index, newNodeError := getNewWorkerID(d, meta, targetEnv, workersInfo)
-						if newNodeError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Unable to find the new worker node info")
-						}
-
-						delete(workersInfo, worker.ID)
-						workersInfo[newWorkerID] = index
-
-						//4. wait for the worker's version update and normal state
-						_, Err := WaitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, cls.MasterKubeVersion, newWorkerID)
-						if Err != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf(
-								"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err)
-						}
-					}
-				}
+				// Keep the patch version of the state, the worker nodes left
+				// are planned again from worker_update_progress
+				oldPatchVersion, _ := d.GetChange("patch_version")
+				d.Set("patch_version", oldPatchVersion)
+				return err
 			}
 		}
 	}
@@ -1455,3 +1480,183 @@ func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.Clust
 	}
 	return "", -1, fmt.Errorf("[ERROR] no new node found")
 }
+
+// workerUpdateStrategy is the expanded worker_update_strategy block
+type workerUpdateStrategy struct {
+	flex.ContainerWorkerUpdateStrategy
+	onFailure string
+}
+
+func expandWorkerUpdateStrategy(d *schema.ResourceData) workerUpdateStrategy {
+	strategy := workerUpdateStrategy{onFailure: workerUpdatePause}
+	strategy.MaxUnavailable = 1
+	if v, ok := d.GetOk("worker_update_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		m := v.([]interface{})[0].(map[string]interface{})
+		strategy.MaxUnavailable, strategy.Percent, _ = flex.ParseContainerWorkerUpdateMaxUnavailable(m["max_unavailable"].(string))
+		strategy.PoolOrder = flex.ExpandStringList(m["worker_pool_order"].([]interface{}))
+		strategy.onFailure = m["on_failure"].(string)
+	}
+	return strategy
+}
+
+func validateWorkerUpdateMaxUnavailable(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := flex.ParseContainerWorkerUpdateMaxUnavailable(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q %s", k, err))
+	}
+	return
+}
+
+// resourceIBMContainerVpcClusterWorkerUpdateDiff plans an update while worker
+// nodes of the last update are pending or failed, so that the next apply
+// continues the update where it stopped
+func resourceIBMContainerVpcClusterWorkerUpdateDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	pending, _ := diff.Get("worker_update_progress.0.pending_workers").([]interface{})
+	failed, _ := diff.Get("worker_update_progress.0.failed_workers").([]interface{})
+	if len(pending) > 0 || len(failed) > 0 {
+		return diff.SetNewComputed("worker_update_progress")
+	}
+	return nil
+}
+
+// updateVpcClusterWorkers replaces the worker nodes that are not at their
+// target kube version, following the worker_update_strategy. The worker nodes
+// left are saved in worker_update_progress, so that the next apply continues
+// with them rather than with all the worker nodes of the cluster.
+func updateVpcClusterWorkers(d *schema.ResourceData, meta interface{}, csClient v2.ContainerServiceAPI, targetEnv v2.ClusterTargetHeader, masterVersion string) error {
+	clusterID := d.Id()
+	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+
+	// Continue the last update if it was to the same version
+	var resume map[string]bool
+	if d.Get("worker_update_progress.0.target_version").(string) == masterVersion {
+		ids := append(flex.ExpandStringList(d.Get("worker_update_progress.0.pending_workers").([]interface{})),
+			flex.ExpandStringList(d.Get("worker_update_progress.0.failed_workers").([]interface{}))...)
+		if len(ids) > 0 {
+			log.Printf("[INFO] Continuing the update of the %d worker nodes left of cluster %s", len(ids), clusterID)
+			resume = make(map[string]bool)
+			for _, id := range ids {
+				resume[id] = true
+			}
+		}
+	}
+
+	var outdated []v2.Worker
+	for _, worker := range workers {
+		// check if change is present in MAJOR.MINOR version or in PATCH version
+		if worker.KubeVersion.Actual != worker.KubeVersion.Target && (resume == nil || resume[worker.ID]) {
+			outdated = append(outdated, worker)
+		}
+	}
+
+	strategy := expandWorkerUpdateStrategy(d)
+	batches := flex.ContainerWorkerUpdateBatches(workers, outdated, strategy.ContainerWorkerUpdateStrategy)
+	pending := make([]string, 0, len(outdated))
+	for _, batch := range batches {
+		for _, worker := range batch {
+			pending = append(pending, worker.ID)
+		}
+	}
+	var failed []string
+	setProgress := func() {
+		d.Set("worker_update_progress", []map[string]interface{}{{
+			"target_version":  masterVersion,
+			"pending_workers": pending,
+			"failed_workers":  failed,
+		}})
+	}
+	setProgress()
+
+	waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
+	for _, batch := range batches {
+		err := replaceVpcClusterWorkers(d, meta, csClient, targetEnv, masterVersion, batch, waitForWorkerUpdate)
+		pending = pending[len(batch):]
+		if err != nil {
+			if strategy.onFailure != workerUpdateContinue {
+				for _, worker := range batch {
+					failed = append(failed, worker.ID)
+				}
+				setProgress()
+				return err
+			}
+			log.Printf("[WARN] Continuing with the next worker nodes of cluster %s: %s", clusterID, err)
+			for _, worker := range batch {
+				failed = append(failed, worker.ID)
+			}
+		}
+		setProgress()
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("[ERROR] Error replacing the worker nodes %s of cluster %s, apply again to retry them", strings.Join(failed, ", "), clusterID)
+	}
+	return nil
+}
+
+// replaceVpcClusterWorkers replaces a batch of worker nodes at the same time and
+// waits for the new worker nodes to be at the kube version of the master
+func replaceVpcClusterWorkers(d *schema.ResourceData, meta interface{}, csClient v2.ContainerServiceAPI, targetEnv v2.ClusterTargetHeader, masterVersion string, batch []v2.Worker, wait bool) error {
+	clusterID := d.Id()
+
+	// workersInfo stores the existing workers info to identify the replaced nodes
+	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	workersInfo := make(map[string]int)
+	for index, worker := range workers {
+		workersInfo[worker.ID] = index
+	}
+	workersCount := len(workers)
+
+	for _, worker := range batch {
+		_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
+		// As API returns http response 204 NO CONTENT, error raised will be exempted.
+		if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+			return fmt.Errorf("[ERROR] Error replacing the worker node %s from the cluster: %s", worker.ID, err)
+		}
+	}
+	if !wait {
+		return nil
+	}
+
+	//1. wait for worker nodes to delete
+	for _, worker := range batch {
+		_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
+		if deleteError != nil {
+			return fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
+		}
+		delete(workersInfo, worker.ID)
+	}
+
+	//2. wait for new workerNodes
+	_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
+	if newWorkerError != nil {
+		return fmt.Errorf("[ERROR] Failed to spawn new worker node")
+	}
+
+	//3. Get new worker node IDs and update the map
+	newWorkerIDs := make([]string, 0, len(batch))
+	for range batch {
+		newWorkerID, index, newNodeError := getNewWorkerID(d, meta, targetEnv, workersInfo)
+		if newNodeError != nil {
+			return fmt.Errorf("[ERROR] Unable to find the new worker node info")
+		}
+		workersInfo[newWorkerID] = index
+		newWorkerIDs = append(newWorkerIDs, newWorkerID)
+	}
+
+	//4. wait for the workers' version update and normal state
+	for _, newWorkerID := range newWorkerIDs {
+		_, Err := WaitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, masterVersion, newWorkerID)
+		if Err != nil {
+			return fmt.Errorf(
+				"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err)
+		}
+	}
+	return nil
+}
@@ -123,6 +123,35 @@ func TestAccIBMContainerVpcClusterImageSecuritySetting(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerVpcClusterWorkerUpdateStrategy(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(clusterName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "worker_update_strategy.0.max_unavailable", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "worker_update_strategy.0.on_failure", "continue"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(clusterName, "50%"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "worker_update_strategy.0.max_unavailable", "50%"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "worker_update_progress.0.pending_workers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMContainerVpcClusterDedicatedHost(t *testing.T) {
 	clusterName := fmt.Sprintf("tf-vpc-cluster-dhost-%d", acctest.RandIntRange(10, 100))
 	hostPoolID := acc.HostPoolID
@@ -393,6 +422,27 @@ func testAccCheckIBMContainerVpcClusterImageSecuritySetting(name, setting string
 	  }`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.SubnetID, setting)
 }
 
+func testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(name, maxUnavailable string) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_cluster" "testacc_vpc_cluster" {
+		name               = "%s"
+		vpc_id             = "%s"
+		flavor             = "bx2.2x8"
+		worker_count       = "2"
+		resource_group_id  = "%s"
+		update_all_workers = true
+		zones {
+			subnet_id = "%s"
+			name      = "us-south-1"
+		  }
+		worker_update_strategy {
+			max_unavailable   = "%s"
+			worker_pool_order = ["default"]
+			on_failure        = "continue"
+		}
+	  }`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.SubnetID, maxUnavailable)
+}
+
 func testAccCheckIBMContainerVpcClusterDedicatedHostSetting(name, vpcID, flavor, subnetID, rgroupID, hostpoolID string) string {
 	return fmt.Sprintf(`
 	resource "ibm_container_vpc_cluster" "testacc_dhost_vpc_cluster" {
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPISharedProcessorPool() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPISharedProcessorPoolRead,
+		Schema: map[string]*schema.Schema{
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SharedProcessorPoolID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Shared processor pool ID or name",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Computed Attributes
+			Attr_SharedProcessorPoolAllocatedCores: {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			Attr_SharedProcessorPoolAvailableCores: {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			Attr_SharedProcessorPoolHostGroup: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			Attr_SharedProcessorPoolHostID: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			Attr_SharedProcessorPoolName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			Attr_SharedProcessorPoolReservedCores: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			Attr_SharedProcessorPoolStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			Attr_SharedProcessorPoolStatusDetail: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			Attr_SharedProcessorPoolInstances: sharedProcessorPoolInstancesSchema(),
+		},
+	}
+}
+
+func dataSourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	poolID := d.Get(Arg_SharedProcessorPoolID).(string)
+
+	client := NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	detail, err := client.Get(poolID)
+	if err != nil {
+		log.Printf("[DEBUG]  err %s", err)
+		return diag.FromErr(err)
+	}
+	pool := detail.SharedProcessorPool
+
+	d.SetId(*pool.ID)
+	d.Set(Attr_SharedProcessorPoolAllocatedCores, pool.AllocatedCores)
+	d.Set(Attr_SharedProcessorPoolAvailableCores, pool.AvailableCores)
+	d.Set(Attr_SharedProcessorPoolHostGroup, pool.HostGroup)
+	d.Set(Attr_SharedProcessorPoolHostID, pool.HostID)
+	d.Set(Attr_SharedProcessorPoolName, pool.Name)
+	d.Set(Attr_SharedProcessorPoolReservedCores, pool.ReservedCores)
+	d.Set(Attr_SharedProcessorPoolStatus, pool.Status)
+	d.Set(Attr_SharedProcessorPoolStatusDetail, pool.StatusDetail)
+	d.Set(Attr_SharedProcessorPoolInstances, flattenSharedProcessorPoolInstances(detail.Servers))
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMPISharedProcessorPools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPISharedProcessorPoolsRead,
+		Schema: map[string]*schema.Schema{
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			// Computed Attributes
+			Attr_SharedProcessorPools: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_SharedProcessorPoolAllocatedCores: {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						Attr_SharedProcessorPoolAvailableCores: {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						Attr_SharedProcessorPoolHostGroup: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						Attr_SharedProcessorPoolHostID: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						Attr_SharedProcessorPoolName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						Attr_SharedProcessorPoolReservedCores: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						Attr_SharedProcessorPoolID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						Attr_SharedProcessorPoolStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						Attr_SharedProcessorPoolStatusDetail: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPISharedProcessorPoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+
+	client := NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	pools, err := client.GetAll()
+	if err != nil {
+		log.Printf("[ERROR] get all shared processor pools failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(pools.SharedProcessorPools))
+	for _, pool := range pools.SharedProcessorPools {
+		if pool == nil {
+			continue
+		}
+		key := map[string]interface{}{
+			Attr_SharedProcessorPoolAllocatedCores: pool.AllocatedCores,
+			Attr_SharedProcessorPoolAvailableCores: pool.AvailableCores,
+			Attr_SharedProcessorPoolHostGroup:      pool.HostGroup,
+			Attr_SharedProcessorPoolHostID:         pool.HostID,
+			Attr_SharedProcessorPoolName:           pool.Name,
+			Attr_SharedProcessorPoolReservedCores:  pool.ReservedCores,
+			Attr_SharedProcessorPoolID:             pool.ID,
+			Attr_SharedProcessorPoolStatus:         pool.Status,
+			Attr_SharedProcessorPoolStatusDetail:   pool.StatusDetail,
+		}
+		result = append(result, key)
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+	d.Set(Attr_SharedProcessorPools, result)
+
+	return nil
+}
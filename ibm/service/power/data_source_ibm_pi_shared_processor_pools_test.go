@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPISharedProcessorPoolsDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-spp-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISharedProcessorPoolsDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_shared_processor_pools.test", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_shared_processor_pools.test", "shared_processor_pools.#"),
+					resource.TestCheckResourceAttr("data.ibm_pi_shared_processor_pool.test", "name", name),
+					resource.TestCheckResourceAttr("data.ibm_pi_shared_processor_pool.test", "reserved_cores", "1"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_shared_processor_pool.test", "instances.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISharedProcessorPoolsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_shared_processor_pool" "power_shared_processor_pool" {
+			pi_shared_processor_pool_name           = "%[1]s"
+			pi_shared_processor_pool_host_group     = "s922"
+			pi_shared_processor_pool_reserved_cores = 1
+			pi_cloud_instance_id                    = "%[2]s"
+		}
+
+		data "ibm_pi_shared_processor_pools" "test" {
+			pi_cloud_instance_id = "%[2]s"
+			depends_on           = [ibm_pi_shared_processor_pool.power_shared_processor_pool]
+		}
+
+		data "ibm_pi_shared_processor_pool" "test" {
+			pi_shared_processor_pool_id = ibm_pi_shared_processor_pool.power_shared_processor_pool.shared_processor_pool_id
+			pi_cloud_instance_id        = "%[2]s"
+		}
+	`, name, acc.Pi_cloud_instance_id)
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// piWorkspaceUsage returns the usage and the limits of the quota of the
+// workspace, or nil when the quota cannot be checked
+func piWorkspaceUsage(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) (usage, limits *models.CloudInstanceUsageLimits) {
+	cloudInstance, err := st.NewIBMPICloudInstanceClient(ctx, sess, cloudInstanceID).Get(cloudInstanceID)
+	if err != nil {
+		flex.CapacityCheckSkipped("error getting the quota of the Power Systems workspace %s: %s", cloudInstanceID, err)
+		return nil, nil
+	}
+	if cloudInstance.Usage == nil || cloudInstance.Limits == nil {
+		return nil, nil
+	}
+	return cloudInstance.Usage, cloudInstance.Limits
+}
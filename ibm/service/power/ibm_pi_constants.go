@@ -54,17 +54,91 @@ const (
 	PISAPInstanceProfileID        = "pi_sap_profile_id"
 	PISAPInstanceDeploymentType   = "pi_sap_deployment_type"
 	PIInstanceStoragePoolAffinity = "pi_storage_pool_affinity"
+	PIInstanceSharedProcessorPool = "pi_shared_processor_pool"
 
 	// Placement Group
 	PIPlacementGroupID      = "placement_group_id"
 	PIPlacementGroupMembers = "members"
 
+	// Shared Processor Pool
+	Arg_SharedProcessorPoolHostGroup        = "pi_shared_processor_pool_host_group"
+	Arg_SharedProcessorPoolID               = "pi_shared_processor_pool_id"
+	Arg_SharedProcessorPoolName             = "pi_shared_processor_pool_name"
+	Arg_SharedProcessorPoolPlacementGroupID = "pi_shared_processor_pool_placement_group_id"
+	Arg_SharedProcessorPoolReservedCores    = "pi_shared_processor_pool_reserved_cores"
+
+	Attr_SharedProcessorPoolAllocatedCores  = "allocated_cores"
+	Attr_SharedProcessorPoolAvailableCores  = "available_cores"
+	Attr_SharedProcessorPoolHostGroup       = "host_group"
+	Attr_SharedProcessorPoolHostID          = "host_id"
+	Attr_SharedProcessorPoolID              = "shared_processor_pool_id"
+	Attr_SharedProcessorPoolInstances       = "instances"
+	Attr_SharedProcessorPoolName            = "name"
+	Attr_SharedProcessorPoolPlacementGroups = "spp_placement_groups"
+	Attr_SharedProcessorPoolReservedCores   = "reserved_cores"
+	Attr_SharedProcessorPools               = "shared_processor_pools"
+	Attr_SharedProcessorPoolStatus          = "status"
+	Attr_SharedProcessorPoolStatusDetail    = "status_detail"
+
+	SharedProcessorPoolActive      = "active"
+	SharedProcessorPoolConfiguring = "configuring"
+	SharedProcessorPoolDeleted     = "deleted"
+	SharedProcessorPoolDeleting    = "deleting"
+	SharedProcessorPoolFailed      = "failed"
+
+	// SPP Placement Group
+	Arg_SPPPlacementGroupName   = "pi_spp_placement_group_name"
+	Arg_SPPPlacementGroupPolicy = "pi_spp_placement_group_policy"
+
+	Attr_SPPPlacementGroupID      = "spp_placement_group_id"
+	Attr_SPPPlacementGroupMembers = "members"
+
+	// Snapshot Restore
+	Arg_SnapshotID                   = "pi_snapshot_id"
+	Arg_SnapshotRestoreFailAction    = "pi_restore_fail_action"
+	Arg_SnapshotRestoreForce         = "pi_restore_force"
+	Arg_SnapshotRestoreStartInstance = "pi_start_instance"
+	Arg_SnapshotRestoreStopInstance  = "pi_stop_instance"
+	Arg_SnapshotRestoreTriggers      = "pi_triggers"
+
+	Attr_SnapshotRestorePercentComplete = "percent_complete"
+	Attr_SnapshotRestoreStatus          = "status"
+	Attr_SnapshotRestoreVolumes         = "volume_restores"
+
+	SnapshotAvailable                 = "available"
+	SnapshotError                     = "error"
+	SnapshotRestoring                 = "restoring"
+	SnapshotRestoreFailActionRetry    = "retry"
+	SnapshotRestoreFailActionRollback = "rollback"
+
 	// Volume
 	PIAffinityPolicy        = "pi_affinity_policy"
 	PIAffinityVolume        = "pi_affinity_volume"
 	PIAffinityInstance      = "pi_affinity_instance"
 	PIAntiAffinityInstances = "pi_anti_affinity_instances"
 	PIAntiAffinityVolumes   = "pi_anti_affinity_volumes"
+	PIReplicationEnabled    = "pi_replication_enabled"
+
+	// Volume Group
+	Arg_ConsistencyGroupName = "pi_consistency_group_name"
+	Arg_VolumeGroupAction    = "pi_volume_group_action"
+	Arg_VolumeGroupID        = "pi_volume_group_id"
+	Arg_VolumeGroupName      = "pi_volume_group_name"
+	Arg_VolumeIDs            = "pi_volume_ids"
+
+	Attr_ConsistencyGroupName    = "consistency_group_name"
+	Attr_ReplicationStatus       = "replication_status"
+	Attr_StatusDescriptionErrors = "status_description_errors"
+	Attr_VolumeGroupID           = "volume_group_id"
+	Attr_VolumeGroupName         = "volume_group_name"
+	Attr_VolumeGroupStatus       = "volume_group_status"
+
+	VolumeGroupAvailable = "available"
+	VolumeGroupCreating  = "creating"
+	VolumeGroupDeleting  = "deleting"
+	VolumeGroupDeleted   = "deleted"
+	VolumeGroupError     = "error"
+	VolumeGroupUpdating  = "updating"
 
 	// VPN
 	PIVPNConnectionId                         = "connection_id"
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_s_p_p_placement_groups"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_shared_processor_pools"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+// IBMPISharedProcessorPoolClient calls the shared processor pool operations of
+// the Power Systems API, which have no client in the instance package of the
+// SDK
+type IBMPISharedProcessorPoolClient struct {
+	ctx             context.Context
+	session         *ibmpisession.IBMPISession
+	cloudInstanceID string
+}
+
+// NewIBMPISharedProcessorPoolClient
+func NewIBMPISharedProcessorPoolClient(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) *IBMPISharedProcessorPoolClient {
+	return &IBMPISharedProcessorPoolClient{
+		ctx:             ctx,
+		session:         sess,
+		cloudInstanceID: cloudInstanceID,
+	}
+}
+
+// Get a Shared Processor Pool and the instances allocated in it
+func (f *IBMPISharedProcessorPoolClient) Get(id string) (*models.SharedProcessorPoolDetail, error) {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsGetParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSharedProcessorPoolID(id)
+	resp, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsGet(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get Shared Processor Pool %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil || resp.Payload.SharedProcessorPool == nil {
+		return nil, fmt.Errorf("failed to Get Shared Processor Pool %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Get All Shared Processor Pools
+func (f *IBMPISharedProcessorPoolClient) GetAll() (*models.SharedProcessorPools, error) {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsGetallParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID)
+	resp, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsGetall(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get All Shared Processor Pools: %w", err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get All Shared Processor Pools")
+	}
+	return resp.Payload, nil
+}
+
+// Create a Shared Processor Pool
+func (f *IBMPISharedProcessorPoolClient) Create(body *models.SharedProcessorPoolCreate) (*models.SharedProcessorPool, error) {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PICreateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithBody(body)
+	resp, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Create Shared Processor Pool for cloud instance %s: %w", f.cloudInstanceID, err)
+	}
+	if resp == nil || resp.Payload == nil || resp.Payload.ID == nil {
+		return nil, fmt.Errorf("failed to Create Shared Processor Pool")
+	}
+	return resp.Payload, nil
+}
+
+// Update the name or the reserved cores of a Shared Processor Pool
+func (f *IBMPISharedProcessorPoolClient) Update(id string, body *models.SharedProcessorPoolUpdate) (*models.SharedProcessorPool, error) {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsPutParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIUpdateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSharedProcessorPoolID(id).WithBody(body)
+	resp, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsPut(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Update Shared Processor Pool %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Update Shared Processor Pool %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Delete a Shared Processor Pool
+func (f *IBMPISharedProcessorPoolClient) Delete(id string) error {
+	params := p_cloud_shared_processor_pools.NewPcloudSharedprocessorpoolsDeleteParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIDeleteTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSharedProcessorPoolID(id)
+	_, err := f.session.Power.PCloudSharedProcessorPools.PcloudSharedprocessorpoolsDelete(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to Delete Shared Processor Pool %s: %w", id, err)
+	}
+	return nil
+}
+
+// IBMPISPPPlacementGroupClient calls the shared processor pool placement group
+// operations of the Power Systems API
+type IBMPISPPPlacementGroupClient struct {
+	ctx             context.Context
+	session         *ibmpisession.IBMPISession
+	cloudInstanceID string
+}
+
+// NewIBMPISPPPlacementGroupClient
+func NewIBMPISPPPlacementGroupClient(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) *IBMPISPPPlacementGroupClient {
+	return &IBMPISPPPlacementGroupClient{
+		ctx:             ctx,
+		session:         sess,
+		cloudInstanceID: cloudInstanceID,
+	}
+}
+
+// Get a Shared Processor Pool Placement Group
+func (f *IBMPISPPPlacementGroupClient) Get(id string) (*models.SPPPlacementGroup, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsGetParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSppPlacementGroupID(id)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsGet(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get Shared Processor Pool Placement Group %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get Shared Processor Pool Placement Group %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Get All Shared Processor Pool Placement Groups
+func (f *IBMPISPPPlacementGroupClient) GetAll() (*models.SPPPlacementGroups, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsGetallParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsGetall(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get All Shared Processor Pool Placement Groups: %w", err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get All Shared Processor Pool Placement Groups")
+	}
+	return resp.Payload, nil
+}
+
+// Create a Shared Processor Pool Placement Group
+func (f *IBMPISPPPlacementGroupClient) Create(body *models.SPPPlacementGroupCreate) (*models.SPPPlacementGroup, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PICreateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithBody(body)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Create Shared Processor Pool Placement Group for cloud instance %s: %w", f.cloudInstanceID, err)
+	}
+	if resp == nil || resp.Payload == nil || resp.Payload.ID == nil {
+		return nil, fmt.Errorf("failed to Create Shared Processor Pool Placement Group")
+	}
+	return resp.Payload, nil
+}
+
+// Delete a Shared Processor Pool Placement Group
+func (f *IBMPISPPPlacementGroupClient) Delete(id string) error {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsDeleteParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIDeleteTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSppPlacementGroupID(id)
+	_, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsDelete(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to Delete Shared Processor Pool Placement Group %s: %w", id, err)
+	}
+	return nil
+}
+
+// Add a Shared Processor Pool to a Shared Processor Pool Placement Group
+func (f *IBMPISPPPlacementGroupClient) AddMember(id, sppID string) (*models.SPPPlacementGroup, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsMembersPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIUpdateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSppPlacementGroupID(id).
+		WithSharedProcessorPoolID(sppID)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsMembersPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Add Shared Processor Pool %s to Placement Group %s: %w", sppID, id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Add Shared Processor Pool %s to Placement Group %s", sppID, id)
+	}
+	return resp.Payload, nil
+}
+
+// Remove a Shared Processor Pool from a Shared Processor Pool Placement Group
+func (f *IBMPISPPPlacementGroupClient) DeleteMember(id, sppID string) (*models.SPPPlacementGroup, error) {
+	params := p_cloud_s_p_p_placement_groups.NewPcloudSppplacementgroupsMembersDeleteParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIDeleteTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithSppPlacementGroupID(id).
+		WithSharedProcessorPoolID(sppID)
+	resp, err := f.session.Power.PCloudsppPlacementGroups.PcloudSppplacementgroupsMembersDelete(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Remove Shared Processor Pool %s from Placement Group %s: %w", sppID, id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Remove Shared Processor Pool %s from Placement Group %s", sppID, id)
+	}
+	return resp.Payload, nil
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+// IBMPIVolumeGroupClient calls the volume group operations of the Power
+// Systems API, which have no client in the instance package of the SDK
+type IBMPIVolumeGroupClient struct {
+	ctx             context.Context
+	session         *ibmpisession.IBMPISession
+	cloudInstanceID string
+}
+
+// NewIBMPIVolumeGroupClient
+func NewIBMPIVolumeGroupClient(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) *IBMPIVolumeGroupClient {
+	return &IBMPIVolumeGroupClient{
+		ctx:             ctx,
+		session:         sess,
+		cloudInstanceID: cloudInstanceID,
+	}
+}
+
+// Get the details of a Volume Group
+func (f *IBMPIVolumeGroupClient) GetDetails(id string) (*models.VolumeGroupDetails, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsGetDetailsParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsGetDetails(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get Volume Group %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get Volume Group %s", id)
+	}
+	return resp.Payload, nil
+}
+
+// Get the details of all Volume Groups
+func (f *IBMPIVolumeGroupClient) GetAllDetails() (*models.VolumeGroupsDetails, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsGetallDetailsParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsGetallDetails(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get All Volume Groups: %w", err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get All Volume Groups")
+	}
+	return resp.Payload, nil
+}
+
+// Create a Volume Group
+func (f *IBMPIVolumeGroupClient) Create(body *models.VolumeGroupCreate) (*models.VolumeGroupCreateResponse, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PICreateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithBody(body)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Create Volume Group for cloud instance %s: %w", f.cloudInstanceID, err)
+	}
+	if resp == nil || resp.Payload == nil || resp.Payload.ID == nil {
+		return nil, fmt.Errorf("failed to Create Volume Group")
+	}
+	return resp.Payload, nil
+}
+
+// Add or remove the volumes of a Volume Group
+func (f *IBMPIVolumeGroupClient) Update(id string, body *models.VolumeGroupUpdate) error {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsPutParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIUpdateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id).WithBody(body)
+	_, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsPut(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to Update Volume Group %s: %w", id, err)
+	}
+	return nil
+}
+
+// Delete a Volume Group
+func (f *IBMPIVolumeGroupClient) Delete(id string) error {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsDeleteParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIDeleteTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id)
+	_, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsDelete(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to Delete Volume Group %s: %w", id, err)
+	}
+	return nil
+}
+
+// Perform a start, stop or reset action on a Volume Group
+func (f *IBMPIVolumeGroupClient) Action(id string, body *models.VolumeGroupAction) error {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsActionPostParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIUpdateTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id).WithBody(body)
+	_, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsActionPost(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to perform the Action on Volume Group %s: %w", id, err)
+	}
+	return nil
+}
+
+// Get the storage details of a Volume Group
+func (f *IBMPIVolumeGroupClient) GetStorageDetails(id string) (*models.VolumeGroupStorageDetails, error) {
+	params := p_cloud_volume_groups.NewPcloudVolumegroupsStorageDetailsGetParams().
+		WithContext(f.ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(f.cloudInstanceID).WithVolumeGroupID(id)
+	resp, err := f.session.Power.PCloudVolumeGroups.PcloudVolumegroupsStorageDetailsGet(params, f.session.AuthInfo(f.cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the Storage Details of Volume Group %s: %w", id, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to Get the Storage Details of Volume Group %s", id)
+	}
+	return resp.Payload, nil
+}
@@ -30,6 +30,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 		UpdateContext: resourceIBMPIInstanceUpdate,
 		DeleteContext: resourceIBMPIInstanceDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMPIInstanceCapacityCheck,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -193,6 +194,22 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Placement group ID",
 			},
+			PIInstanceSharedProcessorPool: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Shared processor pool (ID or name) the instance is deployed on",
+			},
+			"shared_processor_pool": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Shared processor pool name of the instance",
+			},
+			"shared_processor_pool_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Shared processor pool ID of the instance",
+			},
 			"health_status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -344,6 +361,70 @@ func ResourceIBMPIInstance() *schema.Resource {
 	}
 }
 
+// resourceIBMPIInstanceCapacityCheck checks at plan time that the quota of the
+// workspace and the systems of the system type have the instances, processors
+// and memory requested, when capacity_checks is enabled in the provider
+func resourceIBMPIInstanceCapacityCheck(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !flex.CapacityChecksEnabled(meta) {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChanges(helpers.PIInstanceProcessors, helpers.PIInstanceMemory) {
+		return nil
+	}
+	if _, ok := diff.GetOk(PISAPInstanceProfileID); ok {
+		// The processors and memory of the instance come from the SAP profile
+		return nil
+	}
+	for _, key := range []string{helpers.PICloudInstanceId, helpers.PIInstanceProcessors, helpers.PIInstanceMemory, helpers.PIInstanceReplicants} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		flex.CapacityCheckSkipped("%s", err)
+		return nil
+	}
+	cloudInstanceID := diff.Get(helpers.PICloudInstanceId).(string)
+
+	// The processors and memory added to each instance, all of them when it is created
+	oldProcs, newProcs := diff.GetChange(helpers.PIInstanceProcessors)
+	oldMem, newMem := diff.GetChange(helpers.PIInstanceMemory)
+	procs := newProcs.(float64) - oldProcs.(float64)
+	mem := newMem.(float64) - oldMem.(float64)
+	instances := 1.0
+	if diff.Id() == "" {
+		instances = float64(diff.Get(helpers.PIInstanceReplicants).(int))
+	}
+
+	usage, limits := piWorkspaceUsage(ctx, sess, cloudInstanceID)
+	if usage != nil {
+		if diff.Id() == "" {
+			if err := flex.QuotaCheck("instances quota of the Power Systems workspace", "", instances, usage.Instances, limits.Instances); err != nil {
+				return err
+			}
+		}
+		if err := flex.QuotaCheck("processors quota of the Power Systems workspace", "", procs*instances, usage.Processors, limits.Processors); err != nil {
+			return err
+		}
+		if err := flex.QuotaCheck("memory quota of the Power Systems workspace", " GB", mem*instances, usage.Memory, limits.Memory); err != nil {
+			return err
+		}
+	}
+
+	sysType, ok := diff.GetOk(helpers.PIInstanceSystemType)
+	if !ok || !diff.NewValueKnown(helpers.PIInstanceSystemType) {
+		return nil
+	}
+	pools, err := st.NewIBMPISystemPoolClient(ctx, sess, cloudInstanceID).GetSystemPools()
+	if err != nil {
+		flex.CapacityCheckSkipped("error getting the system pools of the Power Systems workspace %s: %s", cloudInstanceID, err)
+		return nil
+	}
+	return flex.PISystemPoolCheck(sysType.(string), pools, procs, mem)
+}
+
 func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Now in the PowerVMCreate")
 	sess, err := meta.(conns.ClientSession).IBMPISession()
@@ -440,6 +521,8 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	if *powervmdata.PlacementGroup != "none" {
 		d.Set(helpers.PIPlacementGroupID, powervmdata.PlacementGroup)
 	}
+	d.Set("shared_processor_pool", powervmdata.SharedProcessorPool)
+	d.Set("shared_processor_pool_id", powervmdata.SharedProcessorPoolID)
 
 	networksMap := []map[string]interface{}{}
 	if powervmdata.Networks != nil {
@@ -1108,6 +1191,10 @@ func createSAPInstance(d *schema.ResourceData, sapClient *st.IBMPISAPInstanceCli
 		body.PlacementGroup = pg.(string)
 	}
 
+	if spp, ok := d.GetOk(PIInstanceSharedProcessorPool); ok {
+		body.SharedProcessorPool = spp.(string)
+	}
+
 	pvmList, err := sapClient.Create(body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to provision: %v", err)
@@ -1267,6 +1354,10 @@ func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, i
 		body.PlacementGroup = pg.(string)
 	}
 
+	if spp, ok := d.GetOk(PIInstanceSharedProcessorPool); ok {
+		body.SharedProcessorPool = spp.(string)
+	}
+
 	if lrc, ok := d.GetOk(helpers.PIInstanceLicenseRepositoryCapacity); ok {
 		// check if using vtl image
 		// check if vtl image is stock image
@@ -0,0 +1,434 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMPISharedProcessorPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPISharedProcessorPoolCreate,
+		ReadContext:   resourceIBMPISharedProcessorPoolRead,
+		UpdateContext: resourceIBMPISharedProcessorPoolUpdate,
+		DeleteContext: resourceIBMPISharedProcessorPoolDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			Arg_CloudInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloud Instance ID - This is the service_instance_id.",
+			},
+			Arg_SharedProcessorPoolName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the shared processor pool",
+			},
+			Arg_SharedProcessorPoolHostGroup: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"s922", "e980"}),
+				Description:  "Host group of the shared processor pool",
+			},
+			Arg_SharedProcessorPoolReservedCores: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of cores reserved for the shared processor pool",
+			},
+			Arg_SharedProcessorPoolPlacementGroupID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the shared processor pool placement group the pool is a member of",
+			},
+
+			// Computed Attributes
+			Attr_SharedProcessorPoolID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Shared processor pool ID",
+			},
+			Attr_SharedProcessorPoolAllocatedCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Number of cores allocated to the instances in the shared processor pool",
+			},
+			Attr_SharedProcessorPoolAvailableCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Number of cores available in the shared processor pool",
+			},
+			Attr_SharedProcessorPoolHostID: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the host the shared processor pool is on",
+			},
+			Attr_SharedProcessorPoolStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the shared processor pool",
+			},
+			Attr_SharedProcessorPoolStatusDetail: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status details of the shared processor pool",
+			},
+			Attr_SharedProcessorPoolPlacementGroups: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Shared processor pool placement groups the pool is a member of",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			Attr_SharedProcessorPoolInstances: sharedProcessorPoolInstancesSchema(),
+		},
+	}
+}
+
+// sharedProcessorPoolInstancesSchema returns the schema of the instances
+// allocated in a shared processor pool
+func sharedProcessorPoolInstancesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Instances allocated in the shared processor pool",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"availability_zone": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cpus": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"memory": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"uncapped": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"vcpus": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	name := d.Get(Arg_SharedProcessorPoolName).(string)
+	hostGroup := d.Get(Arg_SharedProcessorPoolHostGroup).(string)
+	reservedCores := int64(d.Get(Arg_SharedProcessorPoolReservedCores).(int))
+	body := &models.SharedProcessorPoolCreate{
+		Name:          &name,
+		HostGroup:     &hostGroup,
+		ReservedCores: &reservedCores,
+	}
+	if pg, ok := d.GetOk(Arg_SharedProcessorPoolPlacementGroupID); ok {
+		body.PlacementGroupID = pg.(string)
+	}
+
+	client := NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	spp, err := client.Create(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *spp.ID))
+
+	_, err = isWaitForPISharedProcessorPoolAvailable(ctx, client, *spp.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
+}
+
+func resourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sppID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	detail, err := client.Get(sppID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	spp := detail.SharedProcessorPool
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_SharedProcessorPoolName, spp.Name)
+	d.Set(Arg_SharedProcessorPoolHostGroup, spp.HostGroup)
+	d.Set(Arg_SharedProcessorPoolReservedCores, spp.ReservedCores)
+	d.Set(Attr_SharedProcessorPoolID, spp.ID)
+	d.Set(Attr_SharedProcessorPoolAllocatedCores, spp.AllocatedCores)
+	d.Set(Attr_SharedProcessorPoolAvailableCores, spp.AvailableCores)
+	d.Set(Attr_SharedProcessorPoolHostID, spp.HostID)
+	d.Set(Attr_SharedProcessorPoolStatus, spp.Status)
+	d.Set(Attr_SharedProcessorPoolStatusDetail, spp.StatusDetail)
+	d.Set(Attr_SharedProcessorPoolPlacementGroups, flattenSharedProcessorPoolPlacementGroups(spp.SharedProcessorPoolPlacementGroups))
+	d.Set(Attr_SharedProcessorPoolInstances, flattenSharedProcessorPoolInstances(detail.Servers))
+
+	// The pool can be a member of placement groups it was not added to by this
+	// resource; only a removal from the configured group is a drift
+	placementGroupID := d.Get(Arg_SharedProcessorPoolPlacementGroupID).(string)
+	if placementGroupID != "" {
+		member := false
+		for _, pg := range spp.SharedProcessorPoolPlacementGroups {
+			if pg != nil && pg.ID != nil && *pg.ID == placementGroupID {
+				member = true
+			}
+		}
+		if !member {
+			d.Set(Arg_SharedProcessorPoolPlacementGroupID, "")
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sppID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	if d.HasChanges(Arg_SharedProcessorPoolName, Arg_SharedProcessorPoolReservedCores) {
+		body := &models.SharedProcessorPoolUpdate{}
+		if d.HasChange(Arg_SharedProcessorPoolName) {
+			body.Name = d.Get(Arg_SharedProcessorPoolName).(string)
+		}
+		if d.HasChange(Arg_SharedProcessorPoolReservedCores) {
+			body.ReservedCores = int64(d.Get(Arg_SharedProcessorPoolReservedCores).(int))
+		}
+		_, err = client.Update(sppID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForPISharedProcessorPoolAvailable(ctx, client, sppID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(Arg_SharedProcessorPoolPlacementGroupID) {
+		pgClient := NewIBMPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+		oldRaw, newRaw := d.GetChange(Arg_SharedProcessorPoolPlacementGroupID)
+		oldPG := oldRaw.(string)
+		newPG := newRaw.(string)
+
+		if oldPG != "" {
+			log.Printf("[DEBUG] Removing shared processor pool %s from placement group %s", sppID, oldPG)
+			_, err = pgClient.DeleteMember(oldPG, sppID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			_, err = isWaitForPISharedProcessorPoolAvailable(ctx, client, sppID, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if newPG != "" {
+			log.Printf("[DEBUG] Adding shared processor pool %s to placement group %s", sppID, newPG)
+			_, err = pgClient.AddMember(newPG, sppID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			_, err = isWaitForPISharedProcessorPoolAvailable(ctx, client, sppID, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
+}
+
+func resourceIBMPISharedProcessorPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sppID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	err = client.Delete(sppID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = isWaitForPISharedProcessorPoolDeleted(ctx, client, sppID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForPISharedProcessorPoolAvailable(ctx context.Context, client *IBMPISharedProcessorPoolClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Shared Processor Pool (%s) to be active.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", SharedProcessorPoolConfiguring},
+		Target:     []string{SharedProcessorPoolActive},
+		Refresh:    isPISharedProcessorPoolRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPISharedProcessorPoolRefreshFunc(client *IBMPISharedProcessorPoolClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		detail, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+		spp := detail.SharedProcessorPool
+
+		switch spp.Status {
+		case SharedProcessorPoolActive:
+			return spp, SharedProcessorPoolActive, nil
+		case SharedProcessorPoolFailed:
+			return spp, spp.Status, fmt.Errorf("[ERROR] Shared Processor Pool %s failed: %s", id, spp.StatusDetail)
+		}
+
+		return spp, SharedProcessorPoolConfiguring, nil
+	}
+}
+
+func isWaitForPISharedProcessorPoolDeleted(ctx context.Context, client *IBMPISharedProcessorPoolClient, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{SharedProcessorPoolDeleting},
+		Target:     []string{SharedProcessorPoolDeleted},
+		Refresh:    isPISharedProcessorPoolDeleteRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPISharedProcessorPoolDeleteRefreshFunc(client *IBMPISharedProcessorPoolClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		detail, err := client.Get(id)
+		if err != nil {
+			if strings.Contains(err.Error(), "Resource not found") || strings.Contains(err.Error(), "NotFound") {
+				return detail, SharedProcessorPoolDeleted, nil
+			}
+			return nil, "", err
+		}
+		return detail, SharedProcessorPoolDeleting, nil
+	}
+}
+
+func flattenSharedProcessorPoolPlacementGroups(groups []*models.SharedProcessorPoolPlacementGroup) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(groups))
+	for _, pg := range groups {
+		if pg == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"id":     pg.ID,
+			"name":   pg.Name,
+			"policy": pg.Policy,
+		})
+	}
+	return result
+}
+
+func flattenSharedProcessorPoolInstances(servers []*models.SharedProcessorPoolServer) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(servers))
+	for _, s := range servers {
+		if s == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"availability_zone": s.AvailabilityZone,
+			"cpus":              s.Cpus,
+			"id":                s.ID,
+			"memory":            s.Memory,
+			"name":              s.Name,
+			"status":            s.Status,
+			"uncapped":          s.Uncapped,
+			"vcpus":             s.Vcpus,
+		})
+	}
+	return result
+}
@@ -0,0 +1,342 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMPISnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPISnapshotRestoreCreate,
+		ReadContext:   resourceIBMPISnapshotRestoreRead,
+		DeleteContext: resourceIBMPISnapshotRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			Arg_CloudInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloud Instance ID - This is the service_instance_id.",
+			},
+			helpers.PIInstanceName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Instance name / id of the pvm",
+			},
+			Arg_SnapshotID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the PVM instance snapshot to restore",
+			},
+			Arg_VolumeIDs: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "IDs of the volumes expected to be restored; the restore is not started unless the snapshot covers exactly these volumes",
+			},
+			Arg_SnapshotRestoreFailAction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      SnapshotRestoreFailActionRetry,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{SnapshotRestoreFailActionRetry, SnapshotRestoreFailActionRollback}),
+				Description:  "Action to take when the restore fails; retry or rollback",
+			},
+			Arg_SnapshotRestoreForce: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Restores the snapshot even if the instance is not shut off",
+			},
+			Arg_SnapshotRestoreStopInstance: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Stops the instance before the restore",
+			},
+			Arg_SnapshotRestoreStartInstance: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Starts the instance after a successful restore",
+			},
+			Arg_SnapshotRestoreTriggers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, restore the snapshot again",
+			},
+
+			// Computed Attributes
+			Attr_SnapshotRestoreStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the snapshot after the restore",
+			},
+			Attr_SnapshotRestorePercentComplete: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Progress of the restore in percent",
+			},
+			Attr_SnapshotRestoreVolumes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Results of the restore for each volume of the snapshot",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"volume_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the restored volume",
+						},
+						"volume_snapshot_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the volume snapshot the volume was restored from",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the restored volume",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the restored volume",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMPISnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceID := d.Get(helpers.PIInstanceName).(string)
+	snapshotID := d.Get(Arg_SnapshotID).(string)
+	restoreFailAction := d.Get(Arg_SnapshotRestoreFailAction).(string)
+
+	snapshotClient := st.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+	snapshot, err := snapshotClient.Get(snapshotID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API restores every volume of the snapshot, so a volume selection
+	// that does not match the snapshot is rejected before anything changes
+	if v, ok := d.GetOk(Arg_VolumeIDs); ok {
+		volids := flex.ExpandStringList(v.(*schema.Set).List())
+		if err := checkSnapshotRestoreVolumes(snapshot, volids); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	if d.Get(Arg_SnapshotRestoreStopInstance).(bool) {
+		pvm, err := client.Get(instanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if pvm.Status != nil && *pvm.Status == "SHUTOFF" {
+			log.Printf("[DEBUG] the pvm instance %s is already stopped", instanceID)
+		} else {
+			err = stopLparForResourceChange(ctx, client, instanceID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	force := d.Get(Arg_SnapshotRestoreForce).(bool)
+	body := &models.SnapshotRestore{
+		Force: &force,
+	}
+	_, err = client.RestoreSnapShotVM(instanceID, snapshotID, restoreFailAction, body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, snapshotID))
+
+	// The restore has started, so a failure from here on is a warning. As an
+	// error, the restore would be tainted and started again by the next apply.
+	// The status of the snapshot records how the restore ended.
+	var diags diag.Diagnostics
+	_, err = isWaitForPIInstanceSnapshotRestored(ctx, snapshotClient, snapshotID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The restore of snapshot %s did not complete", snapshotID),
+			Detail:   fmt.Sprintf("The restore was started, check the %s of the restore: %s", Attr_SnapshotRestoreStatus, err),
+		})
+	} else if d.Get(Arg_SnapshotRestoreStartInstance).(bool) {
+		err = startLparAfterResourceChange(ctx, client, instanceID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The pvm instance %s was not started after the restore", instanceID),
+				Detail:   fmt.Sprintf("The snapshot %s was restored, start the pvm instance: %s", snapshotID, err),
+			})
+		}
+	}
+
+	return append(diags, resourceIBMPISnapshotRestoreRead(ctx, d, meta)...)
+}
+
+func resourceIBMPISnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, snapshotID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+	snapshot, err := client.Get(snapshotID)
+	if err != nil {
+		// The restore has already happened; keep its results once the snapshot is deleted
+		if strings.Contains(err.Error(), "Resource not found") || strings.Contains(err.Error(), "NotFound") {
+			log.Printf("[DEBUG] snapshot %s no longer exists, keeping the recorded restore results", snapshotID)
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_SnapshotID, snapshotID)
+	d.Set(Attr_SnapshotRestoreStatus, snapshot.Status)
+	d.Set(Attr_SnapshotRestorePercentComplete, snapshot.PercentComplete)
+
+	volumeClient := st.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	d.Set(Attr_SnapshotRestoreVolumes, flattenSnapshotRestoreVolumes(volumeClient, snapshot.VolumeSnapshots))
+
+	return nil
+}
+
+func resourceIBMPISnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There is no delete for a restore; it is only removed from the state
+	d.SetId("")
+	return nil
+}
+
+func checkSnapshotRestoreVolumes(snapshot *models.Snapshot, volids []string) error {
+	selected := make(map[string]bool, len(volids))
+	for _, id := range volids {
+		selected[id] = true
+		if _, ok := snapshot.VolumeSnapshots[id]; !ok {
+			return fmt.Errorf("[ERROR] volume %s is not part of snapshot %s", id, *snapshot.SnapshotID)
+		}
+	}
+	for id := range snapshot.VolumeSnapshots {
+		if !selected[id] {
+			return fmt.Errorf("[ERROR] snapshot %s also restores volume %s, which is not in %s", *snapshot.SnapshotID, id, Arg_VolumeIDs)
+		}
+	}
+	return nil
+}
+
+func flattenSnapshotRestoreVolumes(client *st.IBMPIVolumeClient, volumeSnapshots map[string]string) []map[string]interface{} {
+	volids := make([]string, 0, len(volumeSnapshots))
+	for id := range volumeSnapshots {
+		volids = append(volids, id)
+	}
+	sort.Strings(volids)
+
+	result := make([]map[string]interface{}, 0, len(volids))
+	for _, id := range volids {
+		l := map[string]interface{}{
+			"volume_id":          id,
+			"volume_snapshot_id": volumeSnapshots[id],
+		}
+		vol, err := client.Get(id)
+		if err != nil {
+			log.Printf("[DEBUG] failed to get volume %s of the restored snapshot: %v", id, err)
+		} else {
+			if vol.Name != nil {
+				l["name"] = *vol.Name
+			}
+			l["state"] = vol.State
+		}
+		result = append(result, l)
+	}
+	return result
+}
+
+func isWaitForPIInstanceSnapshotRestored(ctx context.Context, client *st.IBMPISnapshotClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for PIInstance Snapshot (%s) to be restored", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{SnapshotRestoring},
+		Target:     []string{SnapshotAvailable},
+		Refresh:    isPIInstanceSnapshotRestoreRefreshFunc(client, id),
+		Delay:      30 * time.Second,
+		MinTimeout: 1 * time.Minute,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+// isPIInstanceSnapshotRestoreRefreshFunc only accepts an available snapshot
+// once it was seen restoring, since the snapshot is available until the
+// restore has started
+func isPIInstanceSnapshotRestoreRefreshFunc(client *st.IBMPISnapshotClient, id string) resource.StateRefreshFunc {
+	restoring := false
+	return func() (interface{}, string, error) {
+		snapshot, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch strings.ToLower(snapshot.Status) {
+		case SnapshotError:
+			return snapshot, snapshot.Status, fmt.Errorf("[ERROR] failed to restore snapshot %s: action %s ended with status %s", id, snapshot.Action, snapshot.Status)
+		case SnapshotRestoring:
+			restoring = true
+		case SnapshotAvailable:
+			if restoring && snapshot.PercentComplete == 100 {
+				return snapshot, SnapshotAvailable, nil
+			}
+		}
+		return snapshot, SnapshotRestoring, nil
+	}
+}
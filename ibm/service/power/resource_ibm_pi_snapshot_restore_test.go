@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM-Cloud/power-go-client/helpers"
+)
+
+func TestAccIBMPISnapshotRestorebasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-snapshot-restore-%d", acctest.RandIntRange(10, 100))
+	restoreRes := "ibm_pi_snapshot_restore.power_snapshot_restore"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISnapshotRestoreConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(restoreRes, "id"),
+					resource.TestCheckResourceAttr(restoreRes, "status", "available"),
+					resource.TestCheckResourceAttr(restoreRes, "percent_complete", "100"),
+					resource.TestCheckResourceAttr(restoreRes, "volume_restores.#", "1"),
+					resource.TestCheckResourceAttrPair(restoreRes, "volume_restores.0.volume_id", "ibm_pi_volume.power_volume", "volume_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISnapshotRestoreConfig(name string) string {
+	return testAccCheckIBMPIInstanceSnapshotConfig(name, helpers.PIInstanceHealthOk) + fmt.Sprintf(`
+	resource "ibm_pi_snapshot_restore" "power_snapshot_restore" {
+		pi_cloud_instance_id = "%s"
+		pi_instance_name     = ibm_pi_instance.power_instance.pi_instance_name
+		pi_snapshot_id       = ibm_pi_snapshot.power_snapshot.snapshot_id
+		pi_volume_ids        = [ibm_pi_volume.power_volume.volume_id]
+		pi_stop_instance     = true
+		pi_start_instance    = true
+	}
+	`, acc.Pi_cloud_instance_id)
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMPISPPPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPISPPPlacementGroupCreate,
+		ReadContext:   resourceIBMPISPPPlacementGroupRead,
+		DeleteContext: resourceIBMPISPPPlacementGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			Arg_CloudInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloud Instance ID - This is the service_instance_id.",
+			},
+			Arg_SPPPlacementGroupName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the shared processor pool placement group",
+			},
+			Arg_SPPPlacementGroupPolicy: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{models.SPPPlacementGroupCreatePolicyAffinity, models.SPPPlacementGroupCreatePolicyAntiDashAffinity}),
+				Description:  "Policy of the shared processor pool placement group",
+			},
+
+			// Computed Attributes
+			Attr_SPPPlacementGroupID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Shared processor pool placement group ID",
+			},
+			Attr_SPPPlacementGroupMembers: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the shared processor pools that are the placement group members",
+			},
+		},
+	}
+}
+
+func resourceIBMPISPPPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	name := d.Get(Arg_SPPPlacementGroupName).(string)
+	policy := d.Get(Arg_SPPPlacementGroupPolicy).(string)
+	client := NewIBMPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+	body := &models.SPPPlacementGroupCreate{
+		Name:   &name,
+		Policy: &policy,
+	}
+
+	response, err := client.Create(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *response.ID))
+	return resourceIBMPISPPPlacementGroupRead(ctx, d, meta)
+}
+
+func resourceIBMPISPPPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, pgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+	response, err := client.Get(pgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_SPPPlacementGroupName, response.Name)
+	d.Set(Arg_SPPPlacementGroupPolicy, response.Policy)
+	d.Set(Attr_SPPPlacementGroupID, response.ID)
+	d.Set(Attr_SPPPlacementGroupMembers, response.MemberSharedProcessorPools)
+
+	return nil
+}
+
+func resourceIBMPISPPPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, pgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+	err = client.Delete(pgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
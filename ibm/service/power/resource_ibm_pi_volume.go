@@ -29,6 +29,7 @@ func ResourceIBMPIVolume() *schema.Resource {
 		UpdateContext: resourceIBMPIVolumeUpdate,
 		DeleteContext: resourceIBMPIVolumeDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMPIVolumeCapacityCheck,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -109,6 +110,13 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Description:      "List of pvmInstances to base volume anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_volumes is not provided",
 				ConflictsWith:    []string{PIAntiAffinityVolumes},
 			},
+			PIReplicationEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Indicates if the volume should be replication enabled or not",
+			},
 
 			// Computed Attributes
 			"volume_id": {
@@ -132,6 +140,31 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Computed:    true,
 				Description: "WWN Of the volume",
 			},
+			"replication_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication type of the volume, metro or global",
+			},
+			"replication_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication status of the volume",
+			},
+			"mirroring_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Mirroring state of the replication enabled volume",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the volume group the volume belongs to",
+			},
+			"consistency_group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the consistency group of the volume at the storage host level",
+			},
 		},
 	}
 }
@@ -152,6 +185,67 @@ func ResourceIBMPIVolumeValidator() *validate.ResourceValidator {
 	return &ibmPIVolumeResourceValidator
 }
 
+// resourceIBMPIVolumeCapacityCheck checks at plan time that the quota of the
+// workspace and the storage pool or type have the storage requested, when
+// capacity_checks is enabled in the provider
+func resourceIBMPIVolumeCapacityCheck(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !flex.CapacityChecksEnabled(meta) {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(helpers.PIVolumeSize) {
+		return nil
+	}
+	if !diff.NewValueKnown(helpers.PICloudInstanceId) || !diff.NewValueKnown(helpers.PIVolumeSize) {
+		return nil
+	}
+	oldSize, newSize := diff.GetChange(helpers.PIVolumeSize)
+	added := newSize.(float64) - oldSize.(float64)
+	if added <= 0 {
+		return nil
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		flex.CapacityCheckSkipped("%s", err)
+		return nil
+	}
+	cloudInstanceID := diff.Get(helpers.PICloudInstanceId).(string)
+
+	if usage, limits := piWorkspaceUsage(ctx, sess, cloudInstanceID); usage != nil {
+		// The storage quota is in TB
+		if err := flex.QuotaCheck("storage quota of the Power Systems workspace", " TB", added/1024, usage.Storage, limits.Storage); err != nil {
+			return err
+		}
+	}
+
+	client := st.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID)
+	var maxSize *int64
+	var target string
+	if pool, ok := diff.GetOk(helpers.PIVolumePool); ok && diff.NewValueKnown(helpers.PIVolumePool) {
+		target = "storage pool " + pool.(string)
+		capacity, err := client.GetStoragePoolCapacity(pool.(string))
+		if err != nil {
+			flex.CapacityCheckSkipped("error getting the capacity of the %s: %s", target, err)
+			return nil
+		}
+		maxSize = capacity.MaxAllocationSize
+	} else if diskType, ok := diff.GetOk(helpers.PIVolumeType); ok && diff.NewValueKnown(helpers.PIVolumeType) {
+		target = "storage type " + diskType.(string)
+		capacity, err := client.GetStorageTypeCapacity(diskType.(string))
+		if err != nil {
+			flex.CapacityCheckSkipped("error getting the capacity of the %s: %s", target, err)
+			return nil
+		}
+		if capacity.MaximumStorageAllocation != nil {
+			maxSize = capacity.MaximumStorageAllocation.MaxAllocationSize
+		}
+	}
+	if maxSize != nil && added > float64(*maxSize) {
+		return flex.CapacityCheckFailed("The %s can allocate at most %d GB, %g GB requested", target, *maxSize, added)
+	}
+	return nil
+}
+
 func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -178,6 +272,10 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 		volumePool := v.(string)
 		body.VolumePool = volumePool
 	}
+	if v, ok := d.GetOkExists(PIReplicationEnabled); ok {
+		replicationEnabled := v.(bool)
+		body.ReplicationEnabled = &replicationEnabled
+	}
 	if ap, ok := d.GetOk(PIAffinityPolicy); ok {
 		policy := ap.(string)
 		body.AffinityPolicy = &policy
@@ -253,6 +351,12 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("delete_on_termination", vol.DeleteOnTermination)
 	}
 	d.Set("wwn", vol.Wwn)
+	d.Set(PIReplicationEnabled, vol.ReplicationEnabled)
+	d.Set("replication_type", vol.ReplicationType)
+	d.Set("replication_status", vol.ReplicationStatus)
+	d.Set("mirroring_state", vol.MirroringState)
+	d.Set("group_id", vol.GroupID)
+	d.Set("consistency_group_name", vol.ConsistencyGroupName)
 	d.Set(helpers.PICloudInstanceId, cloudInstanceID)
 
 	return nil
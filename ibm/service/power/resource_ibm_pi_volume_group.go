@@ -0,0 +1,345 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMPIVolumeGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeGroupCreate,
+		ReadContext:   resourceIBMPIVolumeGroupRead,
+		UpdateContext: resourceIBMPIVolumeGroupUpdate,
+		DeleteContext: resourceIBMPIVolumeGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			Arg_CloudInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloud Instance ID - This is the service_instance_id.",
+			},
+			Arg_VolumeGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{Arg_VolumeGroupName, Arg_ConsistencyGroupName},
+				Description:  "Name of the volume group to create",
+			},
+			Arg_ConsistencyGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{Arg_VolumeGroupName, Arg_ConsistencyGroupName},
+				Description:  "Name of an existing consistency group on the storage host to create the volume group from",
+			},
+			Arg_VolumeIDs: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "IDs of the volumes in the volume group",
+			},
+
+			// Computed Attributes
+			Attr_VolumeGroupID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume group ID",
+			},
+			Attr_VolumeGroupStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume group status",
+			},
+			Attr_ReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication status of the volume group",
+			},
+			Attr_ConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the consistency group of the volume group on the storage host",
+			},
+			Attr_StatusDescriptionErrors: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Errors reported for the status of the volume group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key of the error",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Message of the error",
+						},
+						"volume_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the volumes the error is reported for",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	body := &models.VolumeGroupCreate{
+		VolumeIDs: flex.ExpandStringList(d.Get(Arg_VolumeIDs).(*schema.Set).List()),
+	}
+	if v, ok := d.GetOk(Arg_VolumeGroupName); ok {
+		body.Name = v.(string)
+	}
+	if v, ok := d.GetOk(Arg_ConsistencyGroupName); ok {
+		body.ConsistencyGroupName = v.(string)
+	}
+
+	client := NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.Create(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *vg.ID))
+
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, *vg.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeGroupRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.GetDetails(vgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_VolumeGroupName, vg.Name)
+	d.Set(Arg_VolumeIDs, vg.VolumeIDs)
+	d.Set(Attr_VolumeGroupID, vg.ID)
+	d.Set(Attr_VolumeGroupStatus, vg.Status)
+	d.Set(Attr_ReplicationStatus, vg.ReplicationStatus)
+	d.Set(Attr_ConsistencyGroupName, vg.ConsistencyGroupName)
+	d.Set(Attr_StatusDescriptionErrors, flattenVolumeGroupStatusDescriptionErrors(vg.StatusDescription))
+
+	return nil
+}
+
+func resourceIBMPIVolumeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	if d.HasChange(Arg_VolumeIDs) {
+		old, new := d.GetChange(Arg_VolumeIDs)
+		oldSet := old.(*schema.Set)
+		newSet := new.(*schema.Set)
+		body := &models.VolumeGroupUpdate{
+			AddVolumes:    flex.ExpandStringList(newSet.Difference(oldSet).List()),
+			RemoveVolumes: flex.ExpandStringList(oldSet.Difference(newSet).List()),
+		}
+		err = client.Update(vgID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIVolumeGroupRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+
+	// A volume group can only be deleted once it has no volumes
+	volumeIDs := flex.ExpandStringList(d.Get(Arg_VolumeIDs).(*schema.Set).List())
+	if len(volumeIDs) > 0 {
+		body := &models.VolumeGroupUpdate{
+			RemoveVolumes: volumeIDs,
+		}
+		err = client.Update(vgID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = client.Delete(vgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = isWaitForIBMPIVolumeGroupDeleted(ctx, client, vgID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForIBMPIVolumeGroupAvailable(ctx context.Context, client *IBMPIVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Group (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", VolumeGroupCreating, VolumeGroupUpdating},
+		Target:     []string{VolumeGroupAvailable},
+		Refresh:    isIBMPIVolumeGroupRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupRefreshFunc(client *IBMPIVolumeGroupClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.GetDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch vg.Status {
+		case VolumeGroupAvailable:
+			return vg, VolumeGroupAvailable, nil
+		case VolumeGroupError:
+			return vg, vg.Status, fmt.Errorf("[ERROR] Volume Group %s is in error status: %s", id, volumeGroupStatusErrorMessages(vg.StatusDescription))
+		case "":
+			return vg, VolumeGroupCreating, nil
+		}
+
+		return vg, VolumeGroupUpdating, nil
+	}
+}
+
+func isWaitForIBMPIVolumeGroupDeleted(ctx context.Context, client *IBMPIVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{VolumeGroupDeleting, VolumeGroupUpdating},
+		Target:     []string{VolumeGroupDeleted},
+		Refresh:    isIBMPIVolumeGroupDeleteRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupDeleteRefreshFunc(client *IBMPIVolumeGroupClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.GetDetails(id)
+		if err != nil {
+			if strings.Contains(err.Error(), "Resource not found") || strings.Contains(err.Error(), "NotFound") {
+				return vg, VolumeGroupDeleted, nil
+			}
+			return nil, "", err
+		}
+		if vg == nil {
+			return vg, VolumeGroupDeleted, nil
+		}
+		return vg, VolumeGroupDeleting, nil
+	}
+}
+
+func flattenVolumeGroupStatusDescriptionErrors(desc *models.StatusDescription) []map[string]interface{} {
+	if desc == nil {
+		return nil
+	}
+	result := make([]map[string]interface{}, 0, len(desc.Errors))
+	for _, e := range desc.Errors {
+		if e == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"key":        e.Key,
+			"message":    e.Message,
+			"volume_ids": e.VolIDs,
+		})
+	}
+	return result
+}
+
+func volumeGroupStatusErrorMessages(desc *models.StatusDescription) string {
+	if desc == nil {
+		return ""
+	}
+	messages := make([]string, 0, len(desc.Errors))
+	for _, e := range desc.Errors {
+		if e != nil && e.Message != "" {
+			messages = append(messages, e.Message)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
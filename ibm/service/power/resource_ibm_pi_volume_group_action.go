@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	volumeGroupActionStart = Arg_VolumeGroupAction + ".0.start"
+	volumeGroupActionStop  = Arg_VolumeGroupAction + ".0.stop"
+	volumeGroupActionReset = Arg_VolumeGroupAction + ".0.reset"
+)
+
+func ResourceIBMPIVolumeGroupAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeGroupActionCreate,
+		ReadContext:   resourceIBMPIVolumeGroupActionRead,
+		DeleteContext: resourceIBMPIVolumeGroupActionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			Arg_CloudInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloud Instance ID - This is the service_instance_id.",
+			},
+			Arg_VolumeGroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Volume group ID",
+			},
+			Arg_VolumeGroupAction: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "Action to perform on the volume group; exactly one of start, stop or reset",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{volumeGroupActionStart, volumeGroupActionStop, volumeGroupActionReset},
+							Description:  "Starts the replication of the volume group",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{models.VolumeGroupActionStartSourceMaster, models.VolumeGroupActionStartSourceAux}),
+										Description:  "Source of the replication; master or aux",
+									},
+								},
+							},
+						},
+						"stop": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{volumeGroupActionStart, volumeGroupActionStop, volumeGroupActionReset},
+							Description:  "Stops the replication of the volume group",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access": {
+										Type:        schema.TypeBool,
+										Required:    true,
+										ForceNew:    true,
+										Description: "Indicates whether the auxiliary volumes are given read/write access once the replication is stopped",
+									},
+								},
+							},
+						},
+						"reset": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{volumeGroupActionStart, volumeGroupActionStop, volumeGroupActionReset},
+							Description:  "Resets the status of the volume group",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{models.VolumeGroupActionResetStatusAvailable}),
+										Description:  "Status to reset the volume group to; available",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Computed Attributes
+			Attr_VolumeGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume group name",
+			},
+			Attr_VolumeGroupStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume group status",
+			},
+			Attr_ReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication status of the volume group",
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeGroupActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+
+	body, err := expandVolumeGroupAction(d.Get(Arg_VolumeGroupAction).([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	err = client.Action(vgID, body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
+
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeGroupActionRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.GetDetails(vgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_VolumeGroupID, vgID)
+	d.Set(Attr_VolumeGroupName, vg.Name)
+	d.Set(Attr_VolumeGroupStatus, vg.Status)
+	d.Set(Attr_ReplicationStatus, vg.ReplicationStatus)
+
+	return nil
+}
+
+func resourceIBMPIVolumeGroupActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There is no delete for an action; it is only removed from the state
+	d.SetId("")
+	return nil
+}
+
+func expandVolumeGroupAction(data []interface{}) (*models.VolumeGroupAction, error) {
+	if len(data) == 0 || data[0] == nil {
+		return nil, fmt.Errorf("[ERROR] one of start, stop or reset must be set in %s", Arg_VolumeGroupAction)
+	}
+	action := data[0].(map[string]interface{})
+
+	if v, ok := action["start"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		source := v[0].(map[string]interface{})["source"].(string)
+		return &models.VolumeGroupAction{
+			Start: &models.VolumeGroupActionStart{Source: &source},
+		}, nil
+	}
+	if v, ok := action["stop"].([]interface{}); ok && len(v) > 0 {
+		// A stop block with access = false can be read as a nil element
+		access := false
+		if v[0] != nil {
+			access = v[0].(map[string]interface{})["access"].(bool)
+		}
+		return &models.VolumeGroupAction{
+			Stop: &models.VolumeGroupActionStop{Access: &access},
+		}, nil
+	}
+	if v, ok := action["reset"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		status := v[0].(map[string]interface{})["status"].(string)
+		return &models.VolumeGroupAction{
+			Reset: &models.VolumeGroupActionReset{Status: &status},
+		}, nil
+	}
+	return nil, fmt.Errorf("[ERROR] one of start, stop or reset must be set in %s", Arg_VolumeGroupAction)
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIVolumeGroupbasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-group-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGroupConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupExists("ibm_pi_volume_group.power_volume_group"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group.power_volume_group", "pi_volume_group_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group.power_volume_group", "pi_volume_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group.power_volume_group", "volume_group_status", "available"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_group.power_volume_group", "consistency_group_name"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeGroupConfig(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupExists("ibm_pi_volume_group.power_volume_group"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group.power_volume_group", "pi_volume_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_pi_volume_group.power_volume_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMPIVolumeGroupActionbasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-group-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGroupActionConfig(name, `
+					stop {
+						access = false
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group_action.power_volume_group_action", "volume_group_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group_action.power_volume_group_action", "volume_group_status", "available"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeGroupActionConfig(name, `
+					start {
+						source = "master"
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_group_action.power_volume_group_action", "volume_group_status", "available"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_group_action.power_volume_group_action", "replication_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeGroupDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_volume_group" {
+			continue
+		}
+		cloudInstanceID, vgID, err := splitID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := power.NewIBMPIVolumeGroupClient(context.Background(), sess, cloudInstanceID)
+		_, err = client.GetDetails(vgID)
+		if err == nil {
+			return fmt.Errorf("PI Volume Group still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPIVolumeGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		cloudInstanceID, vgID, err := splitID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := power.NewIBMPIVolumeGroupClient(context.Background(), sess, cloudInstanceID)
+
+		_, err = client.GetDetails(vgID)
+		return err
+	}
+}
+
+func testAccCheckIBMPIVolumeGroupConfig(name string, volumes int) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_volume" "power_volume" {
+		count                  = 2
+		pi_volume_size         = 20
+		pi_volume_name         = "%[1]s-${count.index}"
+		pi_volume_type         = "tier1"
+		pi_replication_enabled = true
+		pi_cloud_instance_id   = "%[2]s"
+	}
+
+	resource "ibm_pi_volume_group" "power_volume_group" {
+		pi_volume_group_name = "%[1]s"
+		pi_volume_ids        = slice(ibm_pi_volume.power_volume[*].volume_id, 0, %[3]d)
+		pi_cloud_instance_id = "%[2]s"
+	}
+	`, name, acc.Pi_cloud_instance_id, volumes)
+}
+
+func testAccCheckIBMPIVolumeGroupActionConfig(name, action string) string {
+	return testAccCheckIBMPIVolumeGroupConfig(name, 2) + fmt.Sprintf(`
+	resource "ibm_pi_volume_group_action" "power_volume_group_action" {
+		pi_cloud_instance_id = "%s"
+		pi_volume_group_id   = ibm_pi_volume_group.power_volume_group.volume_group_id
+		pi_volume_group_action {
+			%s
+		}
+	}
+	`, acc.Pi_cloud_instance_id, action)
+}
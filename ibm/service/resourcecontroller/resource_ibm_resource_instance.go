@@ -50,6 +50,9 @@ func ResourceIBMResourceInstance() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
@@ -123,6 +126,13 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_resource_instance",
 					"tags"),
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 
 			"status": {
 				Type:        schema.TypeString,
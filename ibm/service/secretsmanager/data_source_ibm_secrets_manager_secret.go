@@ -278,7 +278,7 @@ func dataSourceIBMSecretsManagerSecretRead(context context.Context, d *schema.Re
 		} else {
 			smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
 		}
-		smUrl := conns.EnvFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL)
+		smUrl := meta.(conns.ClientSession).EndpointFallBack("IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT", smEndpointURL)
 		secretsManagerClient.Service.Options.URL = smUrl
 	} else {
 		return diag.FromErr(fmt.Errorf("[ERROR] Invalid or unsupported service Instance"))
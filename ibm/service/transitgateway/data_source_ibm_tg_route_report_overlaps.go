@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	tgKeepRouteReport                  = "keep_route_report"
+	tgRouteOverlaps                    = "overlaps"
+	tgRouteOverlapsRoutes              = "routes"
+	tgRouteOverlapsConflictingPrefixes = "conflicting_prefixes"
+	tgRouteOverlapsConnectionName      = "connection_name"
+	tgRouteOverlapsConnectionType      = "connection_type"
+	tgRouteOverlapsOverlappingPrefix   = "overlapping_prefix"
+	tgRouteOverlapsOverlappingConnID   = "overlapping_connection_id"
+	tgRouteOverlapsOverlappingConnName = "overlapping_connection_name"
+	tgRouteOverlapsOverlappingConnType = "overlapping_connection_type"
+	tgRouteOverlapsConflict            = "conflict"
+)
+
+func DataSourceIBMTransitGatewayRouteReportOverlaps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMTransitGatewayRouteReportOverlapsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			tgGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit Gateway identifier",
+			},
+			tgKeepRouteReport: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the generated route report is kept. By default it is deleted once the overlaps are read.",
+			},
+			tgRouteReportId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the generated route report",
+			},
+			tgRouteOverlapsRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The prefixes the connections of the gateway advertise",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgConnectionId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteOverlapsConnectionName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteOverlapsConnectionType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgPrefix: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			tgRouteOverlaps: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The pairs of routes of different connections whose prefixes overlap",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgPrefix: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgConnectionId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteOverlapsConnectionName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteOverlapsConnectionType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteOverlapsOverlappingPrefix: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteOverlapsOverlappingConnID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteOverlapsOverlappingConnName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteOverlapsOverlappingConnType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgRouteOverlapsConflict: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether both connections advertise the same prefix",
+						},
+					},
+				},
+			},
+			tgRouteOverlapsConflictingPrefixes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The prefixes advertised by more than one connection",
+			},
+		},
+	}
+}
+
+func dataSourceIBMTransitGatewayRouteReportOverlapsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+
+	gatewayId := d.Get(tgGatewayId).(string)
+	routeReport, err := generateTransitGatewayRouteReport(client, gatewayId, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return err
+	}
+	if !d.Get(tgKeepRouteReport).(bool) {
+		if err = deleteTransitGatewayRouteReport(client, gatewayId, *routeReport.ID); err != nil {
+			return err
+		}
+	}
+
+	tgRoutes := flex.NewTgRoutes(routeReport)
+	overlaps, err := flex.TgRouteOverlaps(tgRoutes)
+	if err != nil {
+		return err
+	}
+
+	routes := make([]map[string]interface{}, 0, len(tgRoutes))
+	for _, route := range tgRoutes {
+		routes = append(routes, map[string]interface{}{
+			tgConnectionId:                route.ConnectionID,
+			tgRouteOverlapsConnectionName: route.ConnectionName,
+			tgRouteOverlapsConnectionType: route.ConnectionType,
+			tgPrefix:                      route.Prefix,
+		})
+	}
+
+	overlappingRoutes := make([]map[string]interface{}, 0, len(overlaps))
+	conflictingPrefixes := []string{}
+	seen := map[string]bool{}
+	for _, overlap := range overlaps {
+		overlappingRoutes = append(overlappingRoutes, map[string]interface{}{
+			tgPrefix:                           overlap.Route.Prefix,
+			tgConnectionId:                     overlap.Route.ConnectionID,
+			tgRouteOverlapsConnectionName:      overlap.Route.ConnectionName,
+			tgRouteOverlapsConnectionType:      overlap.Route.ConnectionType,
+			tgRouteOverlapsOverlappingPrefix:   overlap.Overlapping.Prefix,
+			tgRouteOverlapsOverlappingConnID:   overlap.Overlapping.ConnectionID,
+			tgRouteOverlapsOverlappingConnName: overlap.Overlapping.ConnectionName,
+			tgRouteOverlapsOverlappingConnType: overlap.Overlapping.ConnectionType,
+			tgRouteOverlapsConflict:            overlap.Conflict,
+		})
+		if overlap.Conflict && !seen[overlap.Route.Prefix] {
+			seen[overlap.Route.Prefix] = true
+			conflictingPrefixes = append(conflictingPrefixes, overlap.Route.Prefix)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *routeReport.ID))
+	d.Set(tgRouteReportId, *routeReport.ID)
+	d.Set(tgRouteOverlapsRoutes, routes)
+	d.Set(tgRouteOverlaps, overlappingRoutes)
+	d.Set(tgRouteOverlapsConflictingPrefixes, conflictingPrefixes)
+
+	return nil
+}
+
+// generateTransitGatewayRouteReport creates a route report of the gateway and
+// waits for it to complete
+func generateTransitGatewayRouteReport(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId string, timeout time.Duration) (*transitgatewayapisv1.RouteReport, error) {
+	createTransitGatewayRouteReportOptions := &transitgatewayapisv1.CreateTransitGatewayRouteReportOptions{}
+	createTransitGatewayRouteReportOptions.SetTransitGatewayID(gatewayId)
+
+	routeReport, response, err := client.CreateTransitGatewayRouteReport(createTransitGatewayRouteReportOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Create Transit Gateway Route Report err %s\n%s", err, response)
+	}
+
+	report, err := isWaitForTransitGatewayRouteReportAvailable(client, fmt.Sprintf("%s/%s", gatewayId, *routeReport.ID), timeout)
+	if err != nil {
+		// The report is not returned, so it is deleted here or never
+		if deleteErr := deleteTransitGatewayRouteReport(client, gatewayId, *routeReport.ID); deleteErr != nil {
+			log.Printf("[WARN] %s", deleteErr)
+		}
+		return nil, err
+	}
+	return report.(*transitgatewayapisv1.RouteReport), nil
+}
+
+func deleteTransitGatewayRouteReport(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, ID string) error {
+	deleteTransitGatewayRouteReportOptions := &transitgatewayapisv1.DeleteTransitGatewayRouteReportOptions{
+		ID: &ID,
+	}
+	deleteTransitGatewayRouteReportOptions.SetTransitGatewayID(gatewayId)
+	response, err := client.DeleteTransitGatewayRouteReport(deleteTransitGatewayRouteReportOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Route Report (%s): %s\n%s", ID, err, response)
+	}
+	log.Printf("[DEBUG] Deleted transit gateway route report (%s)", ID)
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMTransitGatewayRouteReportOverlapsDataSource_basic(t *testing.T) {
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", acctest.RandIntRange(10, 100))
+	vpcName := fmt.Sprintf("vpc-name-%d", acctest.RandIntRange(10, 100))
+	tgConnectionName := fmt.Sprintf("tg-connection-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMTransitGatewayRouteReportOverlapsDataSourceConfig(gatewayName, vpcName, tgConnectionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_tg_route_report_overlaps.test_tg_route_overlaps", "route_report_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_tg_route_report_overlaps.test_tg_route_overlaps", "routes.#"),
+					resource.TestCheckResourceAttr("data.ibm_tg_route_report_overlaps.test_tg_route_overlaps", "overlaps.#", "0"),
+					resource.TestCheckResourceAttr("data.ibm_tg_route_report_overlaps.test_tg_route_overlaps", "conflicting_prefixes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayRouteReportOverlapsDataSourceConfig(gatewayName, vpcName, tgConnectionName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test_tg_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_tg_gateway" "test_tg_gateway" {
+		name     = "%s"
+		location = "us-south"
+		global   = true
+	}
+
+	resource "ibm_tg_connection" "test_ibm_tg_connection" {
+		gateway      = ibm_tg_gateway.test_tg_gateway.id
+		network_type = "vpc"
+		name         = "%s"
+		network_id   = ibm_is_vpc.test_tg_vpc.resource_crn
+	}
+
+	data "ibm_tg_route_report_overlaps" "test_tg_route_overlaps" {
+		gateway    = ibm_tg_connection.test_ibm_tg_connection.gateway
+		depends_on = [ibm_tg_connection.test_ibm_tg_connection]
+	}
+	`, vpcName, gatewayName, tgConnectionName)
+}
@@ -4,13 +4,17 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -39,6 +43,12 @@ const (
 	tgRemoteTunnelIp                    = "remote_tunnel_ip"
 	tgZone                              = "zone"
 	tgMtu                               = "mtu"
+	tgOverlapCheck                      = "overlap_check"
+	tgOverlapCheckPrefixes              = "overlap_check_prefixes"
+
+	// tgOverlapCheckTimeout is how long a plan waits for the route report of
+	// an overlap check
+	tgOverlapCheckTimeout = 5 * time.Minute
 )
 
 func ResourceIBMTransitGatewayConnection() *schema.Resource {
@@ -50,6 +60,8 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 		Update:   resourceIBMTransitGatewayConnectionUpdate,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMTransitGatewayConnectionOverlapCheck,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -165,6 +177,18 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 				Computed:    true,
 				Description: "Only visible for cross account connections, this field represents the status of the request to connect the given network between accounts.Possible values: [pending,approved,rejected,expired,detached]",
 			},
+			tgOverlapCheck: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_tg_connection", tgOverlapCheck),
+				Description:  "Whether a plan whose prefixes overlap the routes of another connection of the gateway fails (`error`) or is not checked (`off`, the default). The prefixes are the overlap_check_prefixes and, for a vpc connection, the address prefixes of the VPC. The check generates a route report of the gateway.",
+			},
+			tgOverlapCheckPrefixes: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.ValidateCIDR},
+				Description: "The prefixes the connected network advertises, checked against the routes of the other connections of the gateway in addition to the address prefixes of the VPC of a vpc connection.",
+			},
 			flex.RelatedCRN: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -193,6 +217,13 @@ func ResourceIBMTransitGatewayConnectionValidator() *validate.ResourceValidator
 			Regexp:                     `^([a-zA-Z]|[a-zA-Z][-_a-zA-Z0-9]*[a-zA-Z0-9])$`,
 			MinValueLength:             1,
 			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 tgOverlapCheck,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "off, error"})
 
 	ibmTransitGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_tg_connection", Schema: validateSchema}
 
@@ -411,9 +442,11 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response)
+	if d.HasChange(tgName) {
+		_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response)
+		}
 	}
 
 	return resourceIBMTransitGatewayConnectionRead(d, meta)
@@ -526,3 +559,117 @@ func resourceIBMTransitGatewayConnectionExists(d *schema.ResourceData, meta inte
 
 	return true, nil
 }
+
+func resourceIBMTransitGatewayConnectionOverlapCheck(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get(tgOverlapCheck).(string) != "error" {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(tgOverlapCheckPrefixes) && !diff.HasChange(tgOverlapCheck) {
+		return nil
+	}
+	if !diff.NewValueKnown(tgGatewayId) || !diff.NewValueKnown(tgOverlapCheckPrefixes) || !diff.NewValueKnown(tgNetworkId) {
+		log.Printf("[WARN] Skipping the overlap check of the transit gateway connection: its gateway, network or prefixes are not known until apply")
+		return nil
+	}
+
+	prefixes := flex.ExpandStringList(diff.Get(tgOverlapCheckPrefixes).([]interface{}))
+	if diff.Get(tgNetworkType).(string) == "vpc" {
+		vpcPrefixes, err := transitGatewayConnectionVPCPrefixes(context, meta, diff.Get(tgNetworkId).(string))
+		if err != nil {
+			return err
+		}
+		prefixes = append(prefixes, vpcPrefixes...)
+	}
+	if len(prefixes) == 0 {
+		return nil
+	}
+	return checkTransitGatewayRouteOverlaps(meta, diff.Get(tgGatewayId).(string), diff.Get(tgConnectionId).(string), prefixes)
+}
+
+// transitGatewayConnectionVPCPrefixes returns the address prefixes of the VPC
+// of a vpc connection. The VPC client of the provider cannot list the prefixes
+// of a VPC in another account or region, which are only checked when they are
+// in overlap_check_prefixes.
+func transitGatewayConnectionVPCPrefixes(context context.Context, meta interface{}, vpcCRN string) ([]string, error) {
+	// crn:v1:bluemix:public:is:<region>:a/<account>::vpc:<id>
+	crnParts := strings.Split(vpcCRN, ":")
+	if len(crnParts) != 10 || crnParts[8] != "vpc" {
+		log.Printf("[WARN] Skipping the address prefixes of the VPC: %s is not the CRN of a VPC", vpcCRN)
+		return nil, nil
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	if crnParts[5] != bxSession.Config.Region || crnParts[6] != "a/"+userDetails.UserAccount {
+		log.Printf("[WARN] Skipping the address prefixes of VPC %s: it is not in the account and region of the provider", vpcCRN)
+		return nil, nil
+	}
+
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	vpcID := crnParts[9]
+	prefixes := []string{}
+	start := ""
+	for {
+		listVPCAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{
+			VPCID: &vpcID,
+		}
+		if start != "" {
+			listVPCAddressPrefixesOptions.Start = &start
+		}
+		addressPrefixes, response, err := vpcClient.ListVPCAddressPrefixesWithContext(context, listVPCAddressPrefixesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the address prefixes of VPC %s for the overlap check: %s\n%s", vpcID, err, response)
+		}
+		for _, addressPrefix := range addressPrefixes.AddressPrefixes {
+			if addressPrefix.CIDR != nil {
+				prefixes = append(prefixes, *addressPrefix.CIDR)
+			}
+		}
+		start = flex.GetNext(addressPrefixes.Next)
+		if start == "" {
+			break
+		}
+	}
+	return prefixes, nil
+}
+
+// checkTransitGatewayRouteOverlaps fails when a prefix overlaps a route of a
+// connection of the gateway other than connectionId
+func checkTransitGatewayRouteOverlaps(meta interface{}, gatewayId, connectionId string, prefixes []string) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+	routeReport, err := generateTransitGatewayRouteReport(client, gatewayId, tgOverlapCheckTimeout)
+	if err != nil {
+		return err
+	}
+	if err = deleteTransitGatewayRouteReport(client, gatewayId, *routeReport.ID); err != nil {
+		return err
+	}
+
+	routes := flex.NewTgRoutes(routeReport)
+	overlaps := []string{}
+	for _, prefix := range prefixes {
+		overlapping, err := flex.TgOverlappingRoutes(prefix, routes, connectionId)
+		if err != nil {
+			return err
+		}
+		for _, route := range overlapping {
+			overlaps = append(overlaps, fmt.Sprintf("%s overlaps %s of %s connection %s (%s)", prefix, route.Prefix, route.ConnectionType, route.ConnectionName, route.ConnectionID))
+		}
+	}
+	if len(overlaps) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("[ERROR] Prefixes overlap the routes of other connections of transit gateway %s: %s. Set overlap_check to off to apply anyway", gatewayId, strings.Join(overlaps, "; "))
+}
@@ -4,7 +4,9 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -21,6 +23,8 @@ func ResourceIBMTransitGatewayConnectionPrefixFilter() *schema.Resource {
 		Update:   resourceIBMTransitGatewayConnectionPrefixFilterUpdate,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMTransitGatewayConnectionPrefixFilterOverlapCheck,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -80,6 +84,12 @@ func ResourceIBMTransitGatewayConnectionPrefixFilter() *schema.Resource {
 				Computed:    true,
 				Description: "The date and time that this prefix filter was last updated",
 			},
+			tgOverlapCheck: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_tg_connection_prefix_filter", tgOverlapCheck),
+				Description:  "Whether a plan that permits a prefix overlapping the routes of another connection of the gateway fails (`error`) or is not checked (`off`, the default). The check generates a route report of the gateway.",
+			},
 		},
 	}
 }
@@ -94,6 +104,13 @@ func ResourceIBMTransitGatewayConnectionPrefixFilterValidator() *validate.Resour
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              actionValues})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 tgOverlapCheck,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "off, error"})
 
 	ibmTransitGatewayConnectionPrefixFilterResourceValidator := validate.ResourceValidator{ResourceName: "ibm_tg_connection_prefix_filter", Schema: validateSchema}
 
@@ -238,9 +255,11 @@ func resourceIBMTransitGatewayConnectionPrefixFilterUpdate(d *schema.ResourceDat
 		}
 	}
 
-	_, response, err := client.UpdateTransitGatewayConnectionPrefixFilter(updatePrefixFilterOptions)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection Prefix Filter (%s): %s\n%s", filterId, err, response)
+	if d.HasChanges(tgAction, tgBefore, tgGe, tgLe, tgPrefix) {
+		_, response, err := client.UpdateTransitGatewayConnectionPrefixFilter(updatePrefixFilterOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection Prefix Filter (%s): %s\n%s", filterId, err, response)
+		}
 	}
 
 	return resourceIBMTransitGatewayConnectionPrefixFilterRead(d, meta)
@@ -276,3 +295,18 @@ func resourceIBMTransitGatewayConnectionPrefixFilterDelete(d *schema.ResourceDat
 	d.SetId("")
 	return nil
 }
+
+func resourceIBMTransitGatewayConnectionPrefixFilterOverlapCheck(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get(tgOverlapCheck).(string) != "error" || diff.Get(tgAction).(string) != "permit" {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(tgPrefix) && !diff.HasChange(tgAction) && !diff.HasChange(tgConnectionId) && !diff.HasChange(tgOverlapCheck) {
+		return nil
+	}
+	if !diff.NewValueKnown(tgGatewayId) || !diff.NewValueKnown(tgConnectionId) || !diff.NewValueKnown(tgPrefix) {
+		log.Printf("[WARN] Skipping the overlap check of the transit gateway connection prefix filter: its gateway, connection or prefix is not known until apply")
+		return nil
+	}
+
+	return checkTransitGatewayRouteOverlaps(meta, diff.Get(tgGatewayId).(string), diff.Get(tgConnectionId).(string), []string{diff.Get(tgPrefix).(string)})
+}
@@ -6,6 +6,7 @@ package transitgateway_test
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -80,6 +81,45 @@ func TestAccIBMTransitGatewayConnection_basic(t *testing.T) {
 	)
 }
 
+func TestAccIBMTransitGatewayConnection_overlapCheck(t *testing.T) {
+	var tgConnection string
+	tgConnectionName := fmt.Sprintf("tg-connection-name-%d", acctest.RandIntRange(10, 100))
+	tgSecondConnectionName := fmt.Sprintf("tg-connection-name-%d", acctest.RandIntRange(10, 100))
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", acctest.RandIntRange(10, 100))
+	vpcName := fmt.Sprintf("vpc-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMTransitGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayConnectionConfig(tgConnectionName, gatewayName, vpcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMTransitGatewayConnectionExists("ibm_tg_connection.test_ibm_tg_connection", tgConnection),
+				),
+			},
+			{
+				// The default address prefixes of a VPC in us-south include 10.240.0.0/18
+				Config:      testAccCheckIBMTransitGatewayConnectionConfig(tgConnectionName, gatewayName, vpcName) + testAccCheckIBMTransitGatewayConnectionOverlapCheckConfig(tgSecondConnectionName),
+				ExpectError: regexp.MustCompile("Prefixes overlap the routes of other connections"),
+			},
+		},
+	},
+	)
+}
+
+func testAccCheckIBMTransitGatewayConnectionOverlapCheckConfig(connName string) string {
+	return fmt.Sprintf(`
+resource "ibm_tg_connection" "test_ibm_tg_classic_connection" {
+		gateway = ibm_tg_gateway.test_tg_gateway.id
+		network_type = "classic"
+		name = "%s"
+		overlap_check = "error"
+		overlap_check_prefixes = ["10.240.0.0/24"]
+}
+	  `, connName)
+}
+
 func testAccCheckIBMTransitGatewayCrossAccConnectionConfig(vcName, gatewayName, vpcName string) string {
 	return fmt.Sprintf(`	
 	resource "ibm_is_vpc" "test_tg_vpc" {
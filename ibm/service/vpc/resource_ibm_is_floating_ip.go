@@ -52,6 +52,9 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+				},
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceTagsCustomizeDiff(diff)
 				},
@@ -218,6 +221,13 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Floating IP tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -341,7 +351,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("CreateFloatingIP").WithResource("ibm_is_floating_ip", d.Id())
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
@@ -385,7 +395,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetFloatingIP").WithResource("ibm_is_floating_ip", d.Id())
 
 	}
 	d.Set(isFloatingIPName, *floatingip.Name)
@@ -443,7 +453,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		fip, response, err := sess.GetFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Floating IP: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("GetFloatingIP").WithResource("ibm_is_floating_ip", d.Id())
 		}
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
@@ -483,7 +493,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc Floating IP: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("UpdateFloatingIP").WithResource("ibm_is_floating_ip", d.Id())
 		}
 	}
 	return nil
@@ -512,7 +522,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetFloatingIP").WithResource("ibm_is_floating_ip", d.Id())
 	}
 
 	options := &vpcv1.DeleteFloatingIPOptions{
@@ -520,7 +530,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteFloatingIP(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Floating IP : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("DeleteFloatingIP").WithResource("ibm_is_floating_ip", d.Id())
 	}
 	_, err = isWaitForFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -549,7 +559,7 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting floating IP: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).WithOperation("GetFloatingIP").WithResource("ibm_is_floating_ip", d.Id())
 	}
 	return true, nil
 }
@@ -580,7 +590,7 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
 			}
-			return FloatingIP, "", fmt.Errorf("[ERROR] Error Getting Floating IP: %s\n%s", err, response)
+			return FloatingIP, "", flex.NewAPIError(err, response).WithOperation("GetFloatingIP").WithResource("ibm_is_floating_ip", id)
 		}
 		return FloatingIP, isFloatingIPDeleting, err
 	}
@@ -608,7 +618,7 @@ func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resour
 		}
 		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Floating IP for the instance: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).WithOperation("GetFloatingIP").WithResource("ibm_is_floating_ip", id)
 		}
 
 		if *instance.Status == "available" {
@@ -12,8 +12,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/migrate"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -109,7 +113,7 @@ const (
 )
 
 func ResourceIBMISInstance() *schema.Resource {
-	return &schema.Resource{
+	return migrate.Versioned(&schema.Resource{
 		Create: resourceIBMisInstanceCreate,
 		Read:   resourceIBMisInstanceRead,
 		Update: resourceIBMisInstanceUpdate,
@@ -132,7 +136,7 @@ func ResourceIBMISInstance() *schema.Resource {
 						d.SetId("")
 						return nil, nil
 					}
-					return nil, fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+					return nil, flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", "")
 				}
 				var volumes []string
 				volumes = make([]string, 0)
@@ -160,9 +164,16 @@ func ResourceIBMISInstance() *schema.Resource {
 					return flex.InstanceProfileValidate(diff)
 				}),
 			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+				},
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceTagsCustomizeDiff(diff)
 				}),
+			customdiff.Sequence(
+				func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceCapacityCheck(ctx, diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -289,6 +300,13 @@ func ResourceIBMISInstance() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "list of tags for the instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 
 			isEnableCleanDelete: {
 				Type:             schema.TypeBool,
@@ -848,7 +866,26 @@ func ResourceIBMISInstance() *schema.Resource {
 				},
 			},
 		},
+	}, migrate.Upgrade{
+		// primary_ipv4_address of the network interfaces is copied to primary_ip
+		Version: 0,
+		Upgrade: resourceIBMISInstanceStateUpgradeV0,
+	})
+}
+
+func resourceIBMISInstanceStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	nics := append(migrate.Blocks(rawState, isInstancePrimaryNetworkInterface), migrate.Blocks(rawState, isInstanceNetworkInterfaces)...)
+	for _, nic := range nics {
+		address, _ := nic[isInstanceNicPrimaryIpv4Address].(string)
+		if address != "" && migrate.IsEmpty(nic[isInstanceNicPrimaryIP]) {
+			nic[isInstanceNicPrimaryIP] = []interface{}{
+				map[string]interface{}{
+					isInstanceNicReservedIpAddress: address,
+				},
+			}
+		}
 	}
+	return rawState, nil
 }
 
 func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
@@ -2029,7 +2066,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 		}
 		d.Set(isInstanceStatus, *instance.Status)
 
@@ -2143,11 +2180,11 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Instance: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 	}
 	instanceInitialization, response, err := instanceC.GetInstanceInitialization(getinsIniOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting Instance initialization details: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetInstanceInitialization").WithResource("ibm_is_instance", d.Id())
 	}
 	if instanceInitialization.DefaultTrustedProfile != nil && instanceInitialization.DefaultTrustedProfile.AutoLink != nil {
 		d.Set(isInstanceDefaultTrustedProfileAutoLink, *instanceInitialization.DefaultTrustedProfile.AutoLink)
@@ -2240,7 +2277,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("GetInstanceNetworkInterface").WithResource("ibm_is_instance", d.Id())
 		}
 		currentPrimNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 		if insnic.PortSpeed != nil {
@@ -2307,7 +2344,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 				}
 				insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return flex.NewAPIError(err, response).WithOperation("GetInstanceNetworkInterface").WithResource("ibm_is_instance", d.Id())
 				}
 				currentNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 				currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
@@ -2472,8 +2509,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 
-		if vol == nil || err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while expanding boot volume of instance %s/n%s", err, res))
+		if err != nil {
+			return flex.NewAPIError(err, res).WithOperation("UpdateVolume").WithResource("ibm_is_instance", d.Id())
+		}
+		if vol == nil {
+			return fmt.Errorf("[ERROR] Error calling UpdateVolume for ibm_is_instance: empty response")
 		}
 
 		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
@@ -2499,7 +2539,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("CreateInstanceAction").WithResource("ibm_is_instance", d.Id())
 		}
 		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
@@ -2546,7 +2586,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("CreateInstanceAction").WithResource("ibm_is_instance", d.Id())
 		}
 		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
@@ -2563,7 +2603,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			instance, response, err := instanceC.GetInstance(getinsOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+				return flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 			}
 			if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
 				d.Set(isInstanceAction, nil)
@@ -2582,7 +2622,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+				return flex.NewAPIError(err, response).WithOperation("CreateInstanceAction").WithResource("ibm_is_instance", d.Id())
 			}
 			if actiontype == "stop" {
 				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
@@ -2971,7 +3011,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+			return flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 		}
 
 		if instance != nil && *instance.Status == "running" {
@@ -2985,7 +3025,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				if response != nil && response.StatusCode == 404 {
 					return nil
 				}
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+				return flex.NewAPIError(err, response).WithOperation("CreateInstanceAction").WithResource("ibm_is_instance", d.Id())
 			}
 			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 			if err != nil {
@@ -3012,7 +3052,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, response, err = instanceC.UpdateInstance(updnetoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("UpdateInstance").WithResource("ibm_is_instance", d.Id())
 		}
 
 		actiontype := "start"
@@ -3025,7 +3065,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("CreateInstanceAction").WithResource("ibm_is_instance", d.Id())
 		}
 		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
@@ -3039,7 +3079,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	instance, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 	}
 	if d.HasChange(isInstanceTags) {
 		oldList, newList := d.GetChange(isInstanceTags)
@@ -3078,7 +3118,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 	}
 
 	bootvolid := ""
@@ -3094,7 +3134,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("CreateInstanceAction").WithResource("ibm_is_instance", d.Id())
 		}
 		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
@@ -3105,7 +3145,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		vols, response, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Listing volume attachments to the instance: %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("ListInstanceVolumeAttachments").WithResource("ibm_is_instance", d.Id())
 		}
 		for _, vol := range vols.VolumeAttachments {
 			if *vol.Type == "data" {
@@ -3174,7 +3214,7 @@ func instanceExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 	}
 	return true, nil
 }
@@ -3202,7 +3242,7 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 				if response != nil && response.StatusCode == 404 {
 					return instance, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 			}
 			if *instance.Status == isInstanceFailed {
 				return instance, *instance.Status, fmt.Errorf("[ERROR] The  instance %s failed to delete: %v", d.Id(), err)
@@ -3228,7 +3268,7 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 			}
 			select {
 			case data := <-communicator:
@@ -3267,7 +3307,7 @@ func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).WithOperation("GetInstance").WithResource("ibm_is_instance", d.Id())
 			}
 			select {
 			case data := <-communicator:
@@ -3345,7 +3385,7 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 		}
 		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Attaching volume: %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).WithOperation("GetInstanceVolumeAttachment").WithResource("ibm_is_instance", id)
 		}
 
 		if *vol.Status == isInstanceVolumeAttached {
@@ -3371,7 +3411,7 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 				if response != nil && response.StatusCode == 404 {
 					return vol, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error Detaching: %s\n%s", err, response)
+				return nil, "", flex.NewAPIError(err, response).WithOperation("GetInstanceVolumeAttachment").WithResource("ibm_is_instance", d.Id())
 			}
 			if *vol.Status == isInstanceFailed {
 				return vol, *vol.Status, fmt.Errorf("[ERROR] The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
@@ -3431,3 +3471,109 @@ func resourceIbmIsInstanceDedicatedHostGroupReferenceDeletedToMap(dedicatedHostG
 
 	return dedicatedHostGroupReferenceDeletedMap
 }
+
+// resourceIBMISInstanceCapacityCheck checks at plan time that the instance
+// quota of the resource group is not reached, that the profile is available in
+// the region and that the dedicated host has the vCPUs and memory of the
+// profile available, when capacity_checks is enabled in the provider
+func resourceIBMISInstanceCapacityCheck(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !flex.CapacityChecksEnabled(meta) {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(isInstanceProfile) {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		flex.CapacityCheckSkipped("%s", err)
+		return nil
+	}
+	if diff.Id() == "" {
+		if err := isInstanceQuotaCheck(ctx, diff, meta, sess); err != nil {
+			return err
+		}
+	}
+
+	profileName, ok := diff.GetOk(isInstanceProfile)
+	if !ok || !diff.NewValueKnown(isInstanceProfile) {
+		return nil
+	}
+	profile, response, err := sess.GetInstanceProfileWithContext(ctx, sess.NewGetInstanceProfileOptions(profileName.(string)))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return flex.CapacityCheckFailed("The instance profile %s is not available in the region", profileName)
+		}
+		flex.CapacityCheckSkipped("error getting the instance profile %s: %s\n%s", profileName, err, response)
+		return nil
+	}
+
+	if diff.Id() != "" || !diff.NewValueKnown(isInstanceDedicatedHost) {
+		return nil
+	}
+	hostID, ok := diff.GetOk(isInstanceDedicatedHost)
+	if !ok {
+		return nil
+	}
+	host, response, err := sess.GetDedicatedHostWithContext(ctx, sess.NewGetDedicatedHostOptions(hostID.(string)))
+	if err != nil {
+		flex.CapacityCheckSkipped("error getting the dedicated host %s: %s\n%s", hostID, err, response)
+		return nil
+	}
+	return flex.ISDedicatedHostCheck(profile, host)
+}
+
+// isInstanceQuotaCheck checks that the virtual server instance limit of the
+// quota of the resource group of the instance is not reached. The instances
+// are counted in the region of the provider only, so the check may let an
+// exceeded quota through but never fails a plan that is within the quota.
+func isInstanceQuotaCheck(ctx context.Context, diff *schema.ResourceDiff, meta interface{}, sess *vpcv1.VpcV1) error {
+	if !diff.NewValueKnown(isInstanceResourceGroup) {
+		return nil
+	}
+	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
+	if err != nil {
+		flex.CapacityCheckSkipped("%s", err)
+		return nil
+	}
+
+	var quotaID *string
+	if groupID, ok := diff.GetOk(isInstanceResourceGroup); ok {
+		group, response, err := rMgtClient.GetResourceGroupWithContext(ctx, rMgtClient.NewGetResourceGroupOptions(groupID.(string)))
+		if err != nil {
+			flex.CapacityCheckSkipped("error getting the resource group %s: %s\n%s", groupID, err, response)
+			return nil
+		}
+		quotaID = group.QuotaID
+	} else {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			flex.CapacityCheckSkipped("%s", err)
+			return nil
+		}
+		groups, response, err := rMgtClient.ListResourceGroupsWithContext(ctx, &rg.ListResourceGroupsOptions{
+			AccountID: &userDetails.UserAccount,
+			Default:   core.BoolPtr(true),
+		})
+		if err != nil || groups == nil || len(groups.Resources) == 0 {
+			flex.CapacityCheckSkipped("error getting the default resource group: %s\n%s", err, response)
+			return nil
+		}
+		quotaID = groups.Resources[0].QuotaID
+	}
+	if quotaID == nil {
+		return nil
+	}
+	quota, response, err := rMgtClient.GetQuotaDefinitionWithContext(ctx, rMgtClient.NewGetQuotaDefinitionOptions(*quotaID))
+	if err != nil {
+		flex.CapacityCheckSkipped("error getting the quota definition %s: %s\n%s", *quotaID, err, response)
+		return nil
+	}
+
+	instances, response, err := sess.ListInstancesWithContext(ctx, &vpcv1.ListInstancesOptions{Limit: core.Int64Ptr(1)})
+	if err != nil || instances.TotalCount == nil {
+		flex.CapacityCheckSkipped("error counting the instances: %s\n%s", err, response)
+		return nil
+	}
+	used := float64(*instances.TotalCount)
+	return flex.QuotaCheck("virtual server instance quota of the resource group", "", 1, &used, quota.VsiLimit)
+}
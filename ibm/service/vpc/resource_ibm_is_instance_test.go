@@ -4,14 +4,18 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/migrate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -1667,3 +1671,40 @@ func testAccCheckIBMISInstanceConfigWithAvailablePolicyHostFailure_WithTemplate(
   		instance_template   = ibm_is_instance_template.instancetemplate1.id
 	  }`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, templateName, acc.ISZoneName, name)
 }
+
+func TestIBMISInstanceStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"primary_network_interface": []interface{}{
+			map[string]interface{}{"primary_ipv4_address": "10.240.0.4"},
+		},
+		"network_interfaces": []interface{}{
+			map[string]interface{}{
+				"primary_ipv4_address": "10.240.0.5",
+				"primary_ip":           []interface{}{map[string]interface{}{"address": "10.240.0.5", "name": "ip"}},
+			},
+			map[string]interface{}{"primary_ipv4_address": ""},
+		},
+	}
+	want := map[string]interface{}{
+		"primary_network_interface": []interface{}{
+			map[string]interface{}{
+				"primary_ipv4_address": "10.240.0.4",
+				"primary_ip":           []interface{}{map[string]interface{}{"address": "10.240.0.4"}},
+			},
+		},
+		"network_interfaces": []interface{}{
+			map[string]interface{}{
+				"primary_ipv4_address": "10.240.0.5",
+				"primary_ip":           []interface{}{map[string]interface{}{"address": "10.240.0.5", "name": "ip"}},
+			},
+			map[string]interface{}{"primary_ipv4_address": ""},
+		},
+	}
+	got, err := migrate.Apply(context.Background(), vpc.ResourceIBMISInstance(), 0, rawState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -61,9 +60,7 @@ func ResourceIBMISLBListenerPolicy() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
-				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceLBListenerPolicyCustomizeDiff(diff)
-				},
+				validate.InvokeRules("ibm_is_lb_listener_policy"),
 			),
 		),
 
@@ -283,7 +280,27 @@ func ResourceIBMISLBListenerPolicyValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              action})
 
-	ibmISLBListenerPolicyResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_listener_policy", Schema: validateSchema}
+	validateRules := make([]validate.ValidateRule, 0)
+	validateRules = append(validateRules,
+		validate.ValidateRule{
+			RuleIdentifier: validate.RequiredWhen,
+			Identifiers:    []string{isLBListenerPolicyTargetID},
+			When:           isLBListenerPolicyAction,
+			WhenValues:     "forward"})
+	validateRules = append(validateRules,
+		validate.ValidateRule{
+			RuleIdentifier: validate.RequiredWhen,
+			Identifiers:    []string{isLBListenerPolicyTargetHTTPStatusCode, isLBListenerPolicyTargetURL},
+			When:           isLBListenerPolicyAction,
+			WhenValues:     "redirect"})
+	validateRules = append(validateRules,
+		validate.ValidateRule{
+			RuleIdentifier: validate.RequiredWhen,
+			Identifiers:    []string{isLBListenerPolicyHTTPSRedirectListener, isLBListenerPolicyHTTPSRedirectStatusCode},
+			When:           isLBListenerPolicyAction,
+			WhenValues:     "https_redirect"})
+
+	ibmISLBListenerPolicyResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_listener_policy", Schema: validateSchema, Rules: validateRules}
 	return &ibmISLBListenerPolicyResourceValidator
 }
 
@@ -472,8 +489,11 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutCreate), isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -775,8 +795,11 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutUpdate), isLBKey)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
 		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -809,8 +832,11 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 	policyID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := conns.IbmKeyedMutex.LockTimeout(d.Timeout(schema.TimeoutDelete), isLBKey)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	err = lbListenerPolicyDelete(d, meta, lbID, listenerID, policyID)
 	if err != nil {
@@ -50,6 +50,9 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
@@ -107,6 +110,13 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Service tags for the public gateway instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -216,7 +226,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 
 	publicgw, response, err := sess.CreatePublicGateway(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Public Gateway %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("CreatePublicGateway").WithResource("ibm_is_public_gateway", d.Id())
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
@@ -260,7 +270,7 @@ func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.Stat
 		}
 		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+			return nil, "", flex.NewAPIError(err, response).WithOperation("GetPublicGateway").WithResource("ibm_is_public_gateway", id)
 		}
 
 		if *publicgw.Status == isPublicGatewayProvisioningDone {
@@ -286,7 +296,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetPublicGateway").WithResource("ibm_is_public_gateway", d.Id())
 	}
 	d.Set(isPublicGatewayName, *publicgw.Name)
 	if publicgw.FloatingIP != nil {
@@ -342,7 +352,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		}
 		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("GetPublicGateway").WithResource("ibm_is_public_gateway", d.Id())
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
@@ -365,7 +375,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
 		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Public Gateway  : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("UpdatePublicGateway").WithResource("ibm_is_public_gateway", d.Id())
 		}
 	}
 	return resourceIBMISPublicGatewayRead(d, meta)
@@ -387,7 +397,7 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Public Gateway (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetPublicGateway").WithResource("ibm_is_public_gateway", d.Id())
 	}
 
 	deletePublicGatewayOptions := &vpcv1.DeletePublicGatewayOptions{
@@ -395,7 +405,7 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 	}
 	response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Public Gateway : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("DeletePublicGateway").WithResource("ibm_is_public_gateway", d.Id())
 	}
 	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -431,7 +441,7 @@ func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.State
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
 			}
-			return nil, "", fmt.Errorf("[ERROR] The Public Gateway %s failed to delete: %s\n%s", id, err, response)
+			return nil, "", flex.NewAPIError(err, response).WithOperation("GetPublicGateway").WithResource("ibm_is_public_gateway", id)
 		}
 		return pgw, isPublicGatewayDeleting, nil
 	}
@@ -451,7 +461,7 @@ func resourceIBMISPublicGatewayExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Public Gateway: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).WithOperation("GetPublicGateway").WithResource("ibm_is_public_gateway", d.Id())
 	}
 	return true, nil
 }
@@ -39,6 +39,9 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
@@ -73,6 +76,13 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 
 			isSecurityGroupCRN: {
 				Type:        schema.TypeString,
@@ -176,7 +186,7 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("CreateSecurityGroup").WithResource("ibm_is_security_group", d.Id())
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
@@ -207,7 +217,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetSecurityGroup").WithResource("ibm_is_security_group", d.Id())
 	}
 	tags, err := flex.GetTagsUsingCRN(meta, *group.CRN)
 	if err != nil {
@@ -361,7 +371,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Security Group : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("UpdateSecurityGroup").WithResource("ibm_is_security_group", d.Id())
 		}
 	}
 	return resourceIBMISSecurityGroupRead(d, meta)
@@ -383,7 +393,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetSecurityGroup").WithResource("ibm_is_security_group", d.Id())
 	}
 
 	start := ""
@@ -393,8 +403,11 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 		listSecurityGroupTargetsOptions := sess.NewListSecurityGroupTargetsOptions(id)
 
 		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
-		if err != nil || groups == nil {
-			return fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
+		if err != nil {
+			return flex.NewAPIError(err, response).WithOperation("ListSecurityGroupTargets").WithResource("ibm_is_security_group", d.Id())
+		}
+		if groups == nil {
+			return fmt.Errorf("[ERROR] Error calling ListSecurityGroupTargets for ibm_is_security_group: empty response")
 		}
 		if *groups.TotalCount == int64(0) {
 			break
@@ -475,7 +488,7 @@ func resourceIBMISSecurityGroupExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Security Group: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).WithOperation("GetSecurityGroup").WithResource("ibm_is_security_group", d.Id())
 	}
 	return true, nil
 }
@@ -582,8 +595,11 @@ func isSgRefreshFunc(client *vpcv1.VpcV1, sgId string, groups []vpcv1.SecurityGr
 			listSecurityGroupTargetsOptions := client.NewListSecurityGroupTargetsOptions(sgId)
 
 			sggroups, response, err := client.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
-			if err != nil || sggroups == nil {
-				return groups, "", fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
+			if err != nil {
+				return groups, "", flex.NewAPIError(err, response).WithOperation("ListSecurityGroupTargets").WithResource("ibm_is_security_group", "")
+			}
+			if sggroups == nil {
+				return groups, "", fmt.Errorf("[ERROR] Error calling ListSecurityGroupTargets for ibm_is_security_group: empty response")
 			}
 			if *sggroups.TotalCount == int64(0) {
 				return groups, "done", nil
@@ -60,6 +60,9 @@ func ResourceIBMISVolume() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+				},
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceTagsCustomizeDiff(diff)
 				},
@@ -68,6 +71,10 @@ func ResourceIBMISVolume() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceVolumeValidate(diff)
 				}),
+			customdiff.Sequence(
+				func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISVolumeCapacityCheck(ctx, diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -183,6 +190,13 @@ func ResourceIBMISVolume() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "UserTags for the volume instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -830,3 +844,47 @@ func deleteAllSnapshots(sess *vpcv1.VpcV1, id string) error {
 	}
 	return nil
 }
+
+// resourceIBMISVolumeCapacityCheck checks at plan time that the profile is
+// available in the region and that the capacity is not below the minimum
+// capacity of the source snapshot, when capacity_checks is enabled in the
+// provider
+func resourceIBMISVolumeCapacityCheck(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !flex.CapacityChecksEnabled(meta) || diff.Id() != "" {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		flex.CapacityCheckSkipped("%s", err)
+		return nil
+	}
+
+	if profileName, ok := diff.GetOk(isVolumeProfileName); ok && diff.NewValueKnown(isVolumeProfileName) {
+		_, response, err := sess.GetVolumeProfileWithContext(ctx, sess.NewGetVolumeProfileOptions(profileName.(string)))
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return flex.CapacityCheckFailed("The volume profile %s is not available in the region", profileName)
+			}
+			flex.CapacityCheckSkipped("error getting the volume profile %s: %s\n%s", profileName, err, response)
+		}
+	}
+
+	snapshotID, ok := diff.GetOk(isVolumeSourceSnapshot)
+	if !ok || !diff.NewValueKnown(isVolumeSourceSnapshot) || !diff.NewValueKnown(isVolumeCapacity) {
+		return nil
+	}
+	capacity, ok := diff.GetOk(isVolumeCapacity)
+	if !ok {
+		// The volume gets the minimum capacity of the snapshot
+		return nil
+	}
+	snapshot, response, err := sess.GetSnapshotWithContext(ctx, sess.NewGetSnapshotOptions(snapshotID.(string)))
+	if err != nil {
+		flex.CapacityCheckSkipped("error getting the snapshot %s: %s\n%s", snapshotID, err, response)
+		return nil
+	}
+	if snapshot.MinimumCapacity != nil && int64(capacity.(int)) < *snapshot.MinimumCapacity {
+		return flex.CapacityCheckFailed("The capacity of a volume restored from the snapshot %s must be at least %d GB, %d GB requested", snapshotID, *snapshot.MinimumCapacity, capacity)
+	}
+	return nil
+}
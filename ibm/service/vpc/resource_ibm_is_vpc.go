@@ -75,6 +75,9 @@ func ResourceIBMISVPC() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return flex.ResourceDefaultTagsCustomizeDiff(diff, v)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
@@ -185,6 +188,13 @@ func ResourceIBMISVPC() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags, including the default tags configured on the provider",
+			},
 
 			isVPCCRN: {
 				Type:        schema.TypeString,
@@ -476,7 +486,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 
 	vpc, response, err := sess.CreateVPC(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating VPC %s ", flex.BeautifyError(err, response))
+		return flex.NewAPIError(err, response).WithOperation("CreateVPC").WithResource("ibm_is_vpc", d.Id())
 	}
 	d.SetId(*vpc.ID)
 
@@ -531,7 +541,7 @@ func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 		}
 		vpc, response, err := vpc.GetVPC(getvpcOptions)
 		if err != nil {
-			return nil, isVPCFailed, fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+			return nil, isVPCFailed, flex.NewAPIError(err, response).WithOperation("GetVPC").WithResource("ibm_is_vpc", id)
 		}
 
 		if *vpc.Status == isVPCAvailable || *vpc.Status == isVPCFailed {
@@ -565,7 +575,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetVPC").WithResource("ibm_is_vpc", d.Id())
 	}
 
 	d.Set(isVPCName, *vpc.Name)
@@ -633,7 +643,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		s, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("ListSubnets").WithResource("ibm_is_vpc", d.Id())
 		}
 		start = flex.GetNext(s.Next)
 		allrecs = append(allrecs, s.Subnets...)
@@ -807,7 +817,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		vpc, response, err := sess.GetVPC(getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("GetVPC").WithResource("ibm_is_vpc", d.Id())
 		}
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
@@ -847,7 +857,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		updateVpcOptions.VPCPatch = vpcPatch
 		_, response, err := sess.UpdateVPC(updateVpcOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating VPC : %s\n%s", err, response)
+			return flex.NewAPIError(err, response).WithOperation("UpdateVPC").WithResource("ibm_is_vpc", d.Id())
 		}
 	}
 	return nil
@@ -878,7 +888,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).WithOperation("GetVPC").WithResource("ibm_is_vpc", d.Id())
 	}
 
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
@@ -886,7 +896,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPC(deletevpcOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting VPC : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("DeleteVPC").WithResource("ibm_is_vpc", d.Id())
 	}
 	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -922,7 +932,7 @@ func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFu
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
 			}
-			return nil, isVPCFailed, fmt.Errorf("[ERROR] The VPC %s failed to delete: %s\n%s", id, err, response)
+			return nil, isVPCFailed, flex.NewAPIError(err, response).WithOperation("GetVPC").WithResource("ibm_is_vpc", id)
 		}
 
 		return vpc, isVPCDeleting, nil
@@ -948,7 +958,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting VPC: %s\n%s", err, response)
+		return false, flex.NewAPIError(err, response).WithOperation("GetVPC").WithResource("ibm_is_vpc", d.Id())
 	}
 	return true, nil
 }
@@ -974,7 +984,7 @@ func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) name : %s\n%s", id, err, response)
+		return flex.NewAPIError(err, response).WithOperation("UpdateNetworkACL").WithResource("ibm_is_vpc", "")
 	}
 	return nil
 }
@@ -993,7 +1003,7 @@ func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Security Group name : %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("UpdateSecurityGroup").WithResource("ibm_is_vpc", "")
 	}
 	return nil
 }
@@ -1011,7 +1021,7 @@ func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Routing table name %s\n%s", err, response)
+		return flex.NewAPIError(err, response).WithOperation("UpdateVPCRoutingTable").WithResource("ibm_is_vpc", "")
 	}
 	return nil
 }
@@ -18,6 +18,8 @@ import (
 )
 
 func TestAccIBMISVPC_basic(t *testing.T) {
+	defer acc.Replay(t)()
+
 	var vpc string
 	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
 	name2 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
@@ -130,6 +132,38 @@ func TestAccIBMISVPC_securityGroups(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVPC_defaultTags(t *testing.T) {
+	var vpc string
+	name := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultTagsConfig(name, `"env:test"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc", vpc),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "tags.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "tags_all.#", "2"),
+				),
+			},
+			{
+				// a default tag that is also set on the resource must not produce a diff
+				Config: testAccCheckIBMISVPCDefaultTagsConfig(name, `"env:test", "Owner:tf"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc", vpc),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISVPCDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -234,3 +268,15 @@ func testAccCheckIBMISVPCSgConfig(vpcname string, sgname string) string {
 `, vpcname, sgname)
 
 }
+
+func testAccCheckIBMISVPCDefaultTagsConfig(name, tags string) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		default_tags = ["owner:tf"]
+	}
+
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+		tags = [%s]
+	}`, name, tags)
+}
@@ -50,6 +50,8 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 		Exists:   resourceIBMISVPNGatewayConnectionExists,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: validate.InvokeRules("ibm_is_vpn_gateway_connection"),
+
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
@@ -243,7 +245,13 @@ func ResourceIBMISVPNGatewayConnectionValidator() *validate.ResourceValidator {
 			MinValue:                   "2",
 			MaxValue:                   "86399"})
 
-	ibmISVPNGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_gateway_connection", Schema: validateSchema}
+	validateRules := make([]validate.ValidateRule, 0)
+	validateRules = append(validateRules,
+		validate.ValidateRule{
+			RuleIdentifier: validate.NonOverlappingCIDRs,
+			Identifiers:    []string{isVPNGatewayConnectionLocalCIDRS, isVPNGatewayConnectionPeerCIDRS}})
+
+	ibmISVPNGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_gateway_connection", Schema: validateSchema, Rules: validateRules}
 	return &ibmISVPNGatewayConnectionResourceValidator
 }
 
@@ -0,0 +1,284 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// enum to list the cross-attribute and collection rules supported by the
+// validator dictionary. Unlike the ValidateFunc of a ValidateSchema, the rules
+// are evaluated at plan time against the whole resource, so they apply to
+// lists and sets and to several attributes at once.
+type RuleIdentifier int
+
+const (
+	// Exactly one of Identifiers must be set
+	ExactlyOneOf RuleIdentifier = iota
+	// At least one of Identifiers must be set
+	AtLeastOneOf
+	// Identifiers must be set when the When attribute has one of WhenValues
+	RequiredWhen
+	// Identifiers must not be set when the When attribute has one of WhenValues
+	ConflictsWhen
+	// The lists or sets of Identifiers must have at least Count items
+	MinItems
+	// The lists or sets of Identifiers must have at most Count items
+	MaxItems
+	// The items of the lists or sets of Identifiers must be unique
+	UniqueItems
+	// The items of the lists or sets of Identifiers must be CIDRs or IP
+	// addresses that do not overlap each other
+	NonOverlappingCIDRs
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (r RuleIdentifier) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r RuleIdentifier) String() string {
+	return [...]string{"ExactlyOneOf", "AtLeastOneOf", "RequiredWhen", "ConflictsWhen", "MinItems", "MaxItems", "UniqueItems", "NonOverlappingCIDRs"}[r]
+}
+
+// ValidateRule is used to describe a rule on one or more attributes of a
+// resource.
+//
+// Ex: target_id is required when action is forward in ibm_is_lb_listener_policy
+//
+//	validate.ValidateRule{
+//		RuleIdentifier: validate.RequiredWhen,
+//		Identifiers:    []string{"target_id"},
+//		When:           "action",
+//		WhenValues:     "forward"}
+type ValidateRule struct {
+	// The rule that needs to be evaluated.
+	RuleIdentifier RuleIdentifier
+
+	// The parameters the rule applies to.
+	Identifiers []string
+
+	// The field of the blocks of a list or set parameter the collection rules
+	// apply to. Empty for lists and sets of strings.
+	// Ex: address of the allowlist blocks in ibm_database
+	Field string `json:",omitempty"`
+
+	// The parameter and its values, as a comma separated list of strings, that
+	// RequiredWhen and ConflictsWhen depend on.
+	When       string `json:",omitempty"`
+	WhenValues string `json:",omitempty"`
+
+	// The number of items of MinItems and MaxItems.
+	Count int `json:",omitempty"`
+}
+
+// RuleData is the planned state of a resource the rules are evaluated
+// against, implemented by *schema.ResourceDiff.
+type RuleData interface {
+	Id() string
+	GetOk(key string) (interface{}, bool)
+	HasChange(key string) bool
+	NewValueKnown(key string) bool
+}
+
+// InvokeRules returns the CustomizeDiffFunc that evaluates the rules of the
+// resource in the validator dictionary. The rules are looked up when the
+// function runs, so it can be set up before the dictionary.
+func InvokeRules(resourceName string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		return ValidateRules(resourceName, diff)
+	}
+}
+
+// ValidateRules evaluates the rules of the resource in the validator
+// dictionary and returns the error of the first rule that does not hold.
+func ValidateRules(resourceName string, d RuleData) error {
+	resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
+	if resourceItem == nil {
+		return nil
+	}
+	for _, rule := range resourceItem.Rules {
+		if err := rule.Validate(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate evaluates the rule. Rules that depend on a value not known until
+// apply are skipped, and so are rules on existing resources whose attributes
+// do not change, so that existing configurations keep planning.
+func (r ValidateRule) Validate(d RuleData) error {
+	for _, id := range r.Identifiers {
+		if !d.NewValueKnown(id) {
+			return nil
+		}
+	}
+	if r.When != "" && !d.NewValueKnown(r.When) {
+		return nil
+	}
+	if d.Id() != "" && !r.changed(d) {
+		return nil
+	}
+
+	switch r.RuleIdentifier {
+	case ExactlyOneOf, AtLeastOneOf:
+		var set []string
+		for _, id := range r.Identifiers {
+			if _, ok := d.GetOk(id); ok {
+				set = append(set, id)
+			}
+		}
+		if len(set) == 0 {
+			return fmt.Errorf("one of %s must be specified", quoteAll(r.Identifiers))
+		}
+		if r.RuleIdentifier == ExactlyOneOf && len(set) > 1 {
+			return fmt.Errorf("only one of %s can be specified, but %s were specified", quoteAll(r.Identifiers), quoteAll(set))
+		}
+	case RequiredWhen, ConflictsWhen:
+		v, _ := d.GetOk(r.When)
+		value := fmt.Sprint(v)
+		if !stringInSlice(value, splitValues(r.WhenValues)) {
+			return nil
+		}
+		for _, id := range r.Identifiers {
+			_, ok := d.GetOk(id)
+			if r.RuleIdentifier == RequiredWhen && !ok {
+				return fmt.Errorf("%q is required when %q is %q", id, r.When, value)
+			}
+			if r.RuleIdentifier == ConflictsWhen && ok {
+				return fmt.Errorf("%q cannot be specified when %q is %q", id, r.When, value)
+			}
+		}
+	case MinItems, MaxItems:
+		for _, id := range r.Identifiers {
+			n := len(r.items(d, id))
+			if r.RuleIdentifier == MinItems && n < r.Count {
+				return fmt.Errorf("%q must have at least %d items, got %d", id, r.Count, n)
+			}
+			if r.RuleIdentifier == MaxItems && n > r.Count {
+				return fmt.Errorf("%q must have at most %d items, got %d", id, r.Count, n)
+			}
+		}
+	case UniqueItems:
+		for _, id := range r.Identifiers {
+			seen := make(map[string]bool)
+			for _, item := range r.items(d, id) {
+				if seen[item] {
+					return fmt.Errorf("%q must not contain %q more than once", r.key(id), item)
+				}
+				seen[item] = true
+			}
+		}
+	case NonOverlappingCIDRs:
+		for _, id := range r.Identifiers {
+			if err := nonOverlappingCIDRs(r.key(id), r.items(d, id)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// items returns the items of a list or set parameter, or the Field of its
+// blocks, as strings
+func (r ValidateRule) items(d RuleData, id string) []string {
+	v, _ := d.GetOk(id)
+	var list []interface{}
+	switch v := v.(type) {
+	case []interface{}:
+		list = v
+	case *schema.Set:
+		list = v.List()
+	}
+	items := make([]string, 0, len(list))
+	for _, item := range list {
+		if r.Field != "" {
+			block, _ := item.(map[string]interface{})
+			item = block[r.Field]
+		}
+		items = append(items, fmt.Sprint(item))
+	}
+	return items
+}
+
+// changed reports whether one of the parameters the rule depends on changes
+func (r ValidateRule) changed(d RuleData) bool {
+	for _, id := range r.Identifiers {
+		if d.HasChange(id) {
+			return true
+		}
+	}
+	return r.When != "" && d.HasChange(r.When)
+}
+
+func (r ValidateRule) key(id string) string {
+	if r.Field != "" {
+		return id + "." + r.Field
+	}
+	return id
+}
+
+func nonOverlappingCIDRs(k string, items []string) error {
+	networks := make([]*net.IPNet, 0, len(items))
+	for _, item := range items {
+		network, err := parseCIDR(item)
+		if err != nil {
+			return fmt.Errorf("%q must contain valid cidr or ip addresses, got %q", k, item)
+		}
+		for i, other := range networks {
+			if other.Contains(network.IP) || network.Contains(other.IP) {
+				return fmt.Errorf("%q must not contain overlapping addresses, %q overlaps %q", k, item, items[i])
+			}
+		}
+		networks = append(networks, network)
+	}
+	return nil
+}
+
+// parseCIDR parses a CIDR, or an IP address as the CIDR of the address alone
+func parseCIDR(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address %q", s)
+		}
+		bits := 128
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 32
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, network, err := net.ParseCIDR(s)
+	return network, err
+}
+
+func splitValues(s string) []string {
+	values := strings.Split(s, ",")
+	for i, v := range values {
+		values[i] = strings.TrimSpace(v)
+	}
+	return values
+}
+
+func quoteAll(ids []string) string {
+	quoted := make([]string, len(ids))
+	for i, id := range ids {
+		quoted[i] = fmt.Sprintf("%q", id)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// ExportJSON returns the validator dictionary as JSON, for editors and policy
+// tools that check configurations without the provider.
+func ExportJSON(v ValidatorDict) ([]byte, error) {
+	return json.MarshalIndent(v, "", "  ")
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testRuleData is the planned state of a resource, with the unknown
+// attributes listed in unknown and, for an existing resource with an id, the
+// changed attributes listed in changed
+type testRuleData struct {
+	id      string
+	values  map[string]interface{}
+	unknown []string
+	changed []string
+}
+
+func (d testRuleData) Id() string {
+	return d.id
+}
+
+func (d testRuleData) GetOk(key string) (interface{}, bool) {
+	v, ok := d.values[key]
+	return v, ok
+}
+
+func (d testRuleData) HasChange(key string) bool {
+	return stringInSlice(key, d.changed)
+}
+
+func (d testRuleData) NewValueKnown(key string) bool {
+	return !stringInSlice(key, d.unknown)
+}
+
+func TestValidateRule(t *testing.T) {
+	cases := []struct {
+		name string
+		rule ValidateRule
+		data testRuleData
+		err  string
+	}{
+		{"exactly one of none", ValidateRule{RuleIdentifier: ExactlyOneOf, Identifiers: []string{"a", "b"}}, testRuleData{}, `one of "a", "b" must be specified`},
+		{"exactly one of both", ValidateRule{RuleIdentifier: ExactlyOneOf, Identifiers: []string{"a", "b"}}, testRuleData{values: map[string]interface{}{"a": "x", "b": "y"}}, `only one of "a", "b" can be specified`},
+		{"exactly one of unknown", ValidateRule{RuleIdentifier: ExactlyOneOf, Identifiers: []string{"a", "b"}}, testRuleData{unknown: []string{"b"}}, ""},
+		{"at least one of", ValidateRule{RuleIdentifier: AtLeastOneOf, Identifiers: []string{"a", "b"}}, testRuleData{values: map[string]interface{}{"a": "x", "b": "y"}}, ""},
+		{"required when", ValidateRule{RuleIdentifier: RequiredWhen, Identifiers: []string{"target_id"}, When: "action", WhenValues: "forward, redirect"}, testRuleData{values: map[string]interface{}{"action": "forward"}}, `"target_id" is required when "action" is "forward"`},
+		{"required when other value", ValidateRule{RuleIdentifier: RequiredWhen, Identifiers: []string{"target_id"}, When: "action", WhenValues: "forward"}, testRuleData{values: map[string]interface{}{"action": "reject"}}, ""},
+		{"required when unknown", ValidateRule{RuleIdentifier: RequiredWhen, Identifiers: []string{"target_id"}, When: "action", WhenValues: "forward"}, testRuleData{unknown: []string{"action"}}, ""},
+		{"conflicts when bool", ValidateRule{RuleIdentifier: ConflictsWhen, Identifiers: []string{"profile"}, When: "route_mode", WhenValues: "true"}, testRuleData{values: map[string]interface{}{"route_mode": true, "profile": "x"}}, `"profile" cannot be specified when "route_mode" is "true"`},
+		{"max items", ValidateRule{RuleIdentifier: MaxItems, Identifiers: []string{"zones"}, Count: 1}, testRuleData{values: map[string]interface{}{"zones": []interface{}{"a", "b"}}}, `"zones" must have at most 1 items, got 2`},
+		{"min items set", ValidateRule{RuleIdentifier: MinItems, Identifiers: []string{"zones"}, Count: 1}, testRuleData{values: map[string]interface{}{"zones": schema.NewSet(schema.HashString, nil)}}, `"zones" must have at least 1 items, got 0`},
+		{"unique items", ValidateRule{RuleIdentifier: UniqueItems, Identifiers: []string{"nics"}, Field: "name"}, testRuleData{values: map[string]interface{}{"nics": []interface{}{map[string]interface{}{"name": "eth0"}, map[string]interface{}{"name": "eth0"}}}}, `"nics.name" must not contain "eth0" more than once`},
+		{"non overlapping", ValidateRule{RuleIdentifier: NonOverlappingCIDRs, Identifiers: []string{"cidrs"}}, testRuleData{values: map[string]interface{}{"cidrs": []interface{}{"10.0.0.0/24", "10.0.1.0/24", "10.1.0.1"}}}, ""},
+		{"overlapping", ValidateRule{RuleIdentifier: NonOverlappingCIDRs, Identifiers: []string{"cidrs"}}, testRuleData{values: map[string]interface{}{"cidrs": []interface{}{"10.0.0.0/16", "10.0.1.0/24"}}}, `"10.0.1.0/24" overlaps "10.0.0.0/16"`},
+		{"overlapping address", ValidateRule{RuleIdentifier: NonOverlappingCIDRs, Identifiers: []string{"cidrs"}}, testRuleData{values: map[string]interface{}{"cidrs": []interface{}{"10.0.0.5", "10.0.0.0/29"}}}, `"10.0.0.0/29" overlaps "10.0.0.5"`},
+		{"invalid cidr", ValidateRule{RuleIdentifier: NonOverlappingCIDRs, Identifiers: []string{"cidrs"}}, testRuleData{values: map[string]interface{}{"cidrs": []interface{}{"10.0.0.0/33"}}}, `must contain valid cidr or ip addresses`},
+		{"overlapping unchanged", ValidateRule{RuleIdentifier: NonOverlappingCIDRs, Identifiers: []string{"cidrs"}}, testRuleData{id: "r1", values: map[string]interface{}{"cidrs": []interface{}{"10.0.0.0/16", "10.0.1.0/24"}}}, ""},
+		{"overlapping changed", ValidateRule{RuleIdentifier: NonOverlappingCIDRs, Identifiers: []string{"cidrs"}}, testRuleData{id: "r1", values: map[string]interface{}{"cidrs": []interface{}{"10.0.0.0/16", "10.0.1.0/24"}}, changed: []string{"cidrs"}}, `"10.0.1.0/24" overlaps "10.0.0.0/16"`},
+		{"required when changed", ValidateRule{RuleIdentifier: RequiredWhen, Identifiers: []string{"target_id"}, When: "action", WhenValues: "forward"}, testRuleData{id: "r1", values: map[string]interface{}{"action": "forward"}, changed: []string{"action"}}, `"target_id" is required when "action" is "forward"`},
+	}
+	for _, c := range cases {
+		err := c.rule.Validate(c.data)
+		if c.err == "" && err != nil {
+			t.Errorf("%s: unexpected error %s", c.name, err)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%s: expected error %q, got %v", c.name, c.err, err)
+		}
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	defer SetValidatorDict(validatorDict)
+	SetValidatorDict(ValidatorDict{
+		ResourceValidatorDictionary: map[string]*ResourceValidator{
+			"ibm_test": {
+				ResourceName: "ibm_test",
+				Rules: []ValidateRule{
+					{RuleIdentifier: AtLeastOneOf, Identifiers: []string{"a", "b"}},
+					{RuleIdentifier: MaxItems, Identifiers: []string{"c"}, Count: 1},
+				},
+			},
+		},
+	})
+
+	if err := ValidateRules("ibm_test", testRuleData{values: map[string]interface{}{"a": "x", "c": []interface{}{"1", "2"}}}); err == nil || !strings.Contains(err.Error(), `"c" must have at most 1 items`) {
+		t.Errorf("expected the error of the second rule, got %v", err)
+	}
+	if err := ValidateRules("ibm_other", testRuleData{}); err != nil {
+		t.Errorf("expected no error for a resource without rules, got %s", err)
+	}
+
+	b, err := ExportJSON(validatorDict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exported map[string]map[string]map[string]interface{}
+	if err := json.Unmarshal(b, &exported); err != nil {
+		t.Fatal(err)
+	}
+	rules := exported["ResourceValidatorDictionary"]["ibm_test"]["Rules"].([]interface{})
+	if first := rules[0].(map[string]interface{}); first["RuleIdentifier"] != "AtLeastOneOf" {
+		t.Errorf("expected the rule identifier as a string, got %v", first)
+	}
+}
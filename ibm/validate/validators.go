@@ -1148,6 +1148,9 @@ type ResourceValidator struct {
 
 	// Array of validator objects. Each object refers to one parameter in the resource provider.
 	Schema []ValidateSchema
+
+	// Array of rules on several parameters or on list and set parameters, evaluated at plan time by InvokeRules.
+	Rules []ValidateRule `json:",omitempty"`
 }
 
 type ValidatorDict struct {
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Command validators writes the validator dictionary of the provider, with the
+// constraints and rules of the parameters of every resource and data source,
+// as JSON for editors and policy tools.
+//
+//	go run ./scripts/validators -o validators.json
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func main() {
+	out := flag.String("o", "", "file to write the JSON to, standard output when empty")
+	flag.Parse()
+
+	b, err := validate.ExportJSON(provider.Validator())
+	if err != nil {
+		log.Fatalf("[ERROR] Error exporting the validators: %s", err)
+	}
+	b = append(b, '\n')
+	if *out == "" {
+		os.Stdout.Write(b)
+		return
+	}
+	if err := ioutil.WriteFile(*out, b, 0644); err != nil {
+		log.Fatalf("[ERROR] Error writing %s: %s", *out, err)
+	}
+}